@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/multierr"
+)
+
+const (
+	// bucket events counted by a search over splunkd.log, exact but it needs access to _internal
+	bucketEventsSourceSearch = "search"
+	// bucket events estimated from the bucket counts of the indexes endpoint
+	bucketEventsSourceAPI = "api"
+)
+
+// How many buckets of an index rolled from hot to warm and got frozen
+type bucketEvents struct {
+	rolled int64
+	frozen int64
+}
+
+// Counts the buckets of every index that rolled or got frozen recently, keyed by index name. The
+// receiver can learn about these either way, which suits deployments where _internal is out of
+// reach of the account as well as the others
+type bucketEventSource interface {
+	bucketEvents(ctx context.Context, now pcommon.Timestamp) (map[string]bucketEvents, error)
+	// the endpoint the events are scraped from, see metricEndpoints
+	endpoint() string
+}
+
+func newBucketEventSource(s *instanceScraper) bucketEventSource {
+	if s.conf.BucketEventsSource == bucketEventsSourceAPI {
+		return &apiBucketEvents{s: s}
+	}
+	return &searchBucketEvents{s: s}
+}
+
+// Counts the rolls logged by HotBucketRoller and the freezes logged by BucketMover over the last
+// 10 minutes. Freezes only log the path of the bucket, which is why they are reported under the
+// directory of the index rather than its name when the two differ, e.g. defaultdb for main
+type searchBucketEvents struct {
+	s *instanceScraper
+}
+
+func (b *searchBucketEvents) bucketEvents(ctx context.Context, now pcommon.Timestamp) (map[string]bucketEvents, error) {
+	results, err := b.s.sharedSearch(ctx, now, `SplunkBucketEventsSearch`)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs error
+	events := make(map[string]bucketEvents)
+	for _, row := range results {
+		var e bucketEvents
+		if e.rolled, err = strconv.ParseInt(row.value("rolled"), 10, 64); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		if e.frozen, err = strconv.ParseInt(row.value("frozen"), 10, 64); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		events[row.value("index_name")] = e
+	}
+
+	return events, errs
+}
+
+func (b *searchBucketEvents) endpoint() string {
+	return searchJobsEndpoint
+}
+
+// Estimates the events from how the number of warm and cold buckets of every index changed since
+// the previous scrape: a roll adds one and a freeze takes one away. Rolls and freezes happening
+// between the same two scrapes cancel out, so the counts are lower bounds that get closer to the
+// truth the shorter the collection interval. The first scrape only takes note of the counts
+type apiBucketEvents struct {
+	s *instanceScraper
+	sync.Mutex
+	// warm and cold buckets of every index as of the previous scrape
+	last map[string]int64
+}
+
+func (b *apiBucketEvents) bucketEvents(ctx context.Context, _ pcommon.Timestamp) (map[string]bucketEvents, error) {
+	counts := make(map[string]int64)
+	err := b.s.getAllPages(ctx, b.s.api[`SplunkIndexesExtended`], func(body []byte) (paging, int, error) {
+		var ie indexesExtended
+		if err := json.Unmarshal(body, &ie); err != nil {
+			return paging{}, 0, err
+		}
+		for _, entry := range ie.Entries {
+			dirs := entry.Content.BucketDirs
+			if dirs.Home.WarmBucketCount.ok && dirs.Cold.BucketCount.ok {
+				counts[entry.Name] = int64(dirs.Home.WarmBucketCount.value + dirs.Cold.BucketCount.value)
+			}
+		}
+		return ie.Paging, len(ie.Entries), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	events := make(map[string]bucketEvents)
+	for name, count := range counts {
+		last, ok := b.last[name]
+		if !ok {
+			continue
+		}
+		var e bucketEvents
+		if count > last {
+			e.rolled = count - last
+		} else {
+			e.frozen = last - count
+		}
+		events[name] = e
+	}
+	b.last = counts
+
+	return events, nil
+}
+
+func (b *apiBucketEvents) endpoint() string {
+	return b.s.api[`SplunkIndexesExtended`]
+}
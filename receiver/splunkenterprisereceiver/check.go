@@ -0,0 +1,272 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"text/tabwriter"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
+)
+
+// stands in for the search jobs endpoint of the instance, which depends on the configured namespace
+const searchJobsEndpoint = "<search jobs>"
+
+// An endpoint a metric is scraped from
+type metricEndpoint struct {
+	metric   string
+	enabled  bool
+	endpoint string
+}
+
+// Every endpoint each metric is scraped from, with the paths of api. Search based metrics are
+// dispatched through the search jobs endpoint
+func metricEndpoints(m metadata.MetricsConfig, bucketEvents bucketEventSource, api map[string]string) []metricEndpoint {
+	return []metricEndpoint{
+		{"splunk.up", m.SplunkUp.Enabled, api[`SplunkServerInfo`]},
+		{"splunk.license.index.usage", m.SplunkLicenseIndexUsage.Enabled, searchJobsEndpoint},
+		{"splunk.license.pool.used.bytes", m.SplunkLicensePoolUsedBytes.Enabled, api[`SplunkLicensePools`]},
+		{"splunk.license.pool.quota.bytes", m.SplunkLicensePoolQuotaBytes.Enabled, api[`SplunkLicensePools`]},
+		{"splunk.license.slave.count", m.SplunkLicenseSlaveCount.Enabled, api[`SplunkLicenseSlaves`]},
+		{"splunk.license.warning.count", m.SplunkLicenseWarningCount.Enabled, api[`SplunkLicenseMessages`]},
+		{"splunk.license.violation", m.SplunkLicenseViolation.Enabled, api[`SplunkLicenseMessages`]},
+		{"splunk.index.bucket.count", m.SplunkIndexBucketCount.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.raw.size.bytes", m.SplunkIndexRawSizeBytes.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.event.count", m.SplunkIndexEventCount.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.earliest.event.seconds", m.SplunkIndexEarliestEventSeconds.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.latest.event.seconds", m.SplunkIndexLatestEventSeconds.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.hot.buckets.count", m.SplunkIndexHotBucketsCount.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.hot.buckets.max", m.SplunkIndexHotBucketsMax.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.frozen.time.seconds", m.SplunkIndexFrozenTimeSeconds.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.max.size.bytes", m.SplunkIndexMaxSizeBytes.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.thawed.size.bytes", m.SplunkIndexThawedSizeBytes.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.indexes.count", m.SplunkIndexesCount.Enabled, api[`SplunkIndexesExtended`]},
+		{"splunk.index.buckets.rolled.count", m.SplunkIndexBucketsRolledCount.Enabled, bucketEvents.endpoint()},
+		{"splunk.index.buckets.frozen.count", m.SplunkIndexBucketsFrozenCount.Enabled, bucketEvents.endpoint()},
+		{"splunk.indexer.throughput", m.SplunkIndexerThroughput.Enabled, api[`SplunkIndexerThroughput`]},
+		{"splunk.indexer.throughput.by_sourcetype", m.SplunkIndexerThroughputBySourcetype.Enabled, searchJobsEndpoint},
+		{"splunk.sourcetype.event.count", m.SplunkSourcetypeEventCount.Enabled, searchJobsEndpoint},
+		{"splunk.indexer.ingestion.latency.seconds", m.SplunkIndexerIngestionLatencySeconds.Enabled, searchJobsEndpoint},
+		{"splunk.index.events.written.rate", m.SplunkIndexEventsWrittenRate.Enabled, searchJobsEndpoint},
+		{"splunk.index.events.searched.rate", m.SplunkIndexEventsSearchedRate.Enabled, searchJobsEndpoint},
+		{"splunk.index.search.duration.seconds", m.SplunkIndexSearchDurationSeconds.Enabled, searchJobsEndpoint},
+		{"splunk.search.count", m.SplunkSearchCount.Enabled, searchJobsEndpoint},
+		{"splunk.indexer.queue.ratio", m.SplunkIndexerQueueRatio.Enabled, api[`SplunkIndexerQueueRatio`]},
+		{"splunk.indexer.queue.latency.seconds", m.SplunkIndexerQueueLatencySeconds.Enabled, api[`SplunkIndexerQueueRatio`]},
+		{"splunk.indexer.queue.latency.seconds", m.SplunkIndexerQueueLatencySeconds.Enabled, api[`SplunkIndexerThroughput`]},
+		{"splunk.search.scan.count", m.SplunkSearchScanCount.Enabled, searchJobsEndpoint},
+		{"splunk.search.event.count", m.SplunkSearchEventCount.Enabled, searchJobsEndpoint},
+		{"splunk.search.run.duration.seconds", m.SplunkSearchRunDurationSeconds.Enabled, searchJobsEndpoint},
+		{"splunk.receiver.search.wait.seconds", m.SplunkReceiverSearchWaitSeconds.Enabled, searchJobsEndpoint},
+		{"splunk.search.timeout.count", m.SplunkSearchTimeoutCount.Enabled, searchJobsEndpoint},
+		{"splunk.auth.failures.count", m.SplunkAuthFailuresCount.Enabled, api[`SplunkServerInfo`]},
+		{"splunk.scheduler.skipped.count", m.SplunkSchedulerSkippedCount.Enabled, searchJobsEndpoint},
+		{"splunk.scheduler.lag.seconds", m.SplunkSchedulerLagSeconds.Enabled, searchJobsEndpoint},
+		{"splunk.scheduler.execution.duration", m.SplunkSchedulerExecutionDuration.Enabled, searchJobsEndpoint},
+		{"splunk.savedsearch.alert.fired.count", m.SplunkSavedsearchAlertFiredCount.Enabled, searchJobsEndpoint},
+		{"splunk.savedsearch.alert.suppressed.count", m.SplunkSavedsearchAlertSuppressedCount.Enabled, searchJobsEndpoint},
+		{"splunk.alerts.triggered.count", m.SplunkAlertsTriggeredCount.Enabled, api[`SplunkFiredAlerts`]},
+		{"splunk.alerts.triggered.oldest.seconds", m.SplunkAlertsTriggeredOldestSeconds.Enabled, api[`SplunkFiredAlerts`]},
+		{"splunk.kvstore.status", m.SplunkKvstoreStatus.Enabled, api[`SplunkKVStoreStatus`]},
+		{"splunk.kvstore.replication.status", m.SplunkKvstoreReplicationStatus.Enabled, api[`SplunkKVStoreStatus`]},
+		{"splunk.kvstore.backup.restore.status", m.SplunkKvstoreBackupRestoreStatus.Enabled, api[`SplunkKVStoreStatus`]},
+		{"splunk.kvstore.collection.size.bytes", m.SplunkKvstoreCollectionSizeBytes.Enabled, api[`SplunkKVStoreCollections`]},
+		{"splunk.kvstore.collection.count", m.SplunkKvstoreCollectionCount.Enabled, api[`SplunkKVStoreCollections`]},
+		{"splunk.shc.member.status", m.SplunkShcMemberStatus.Enabled, api[`SplunkSHCMemberInfo`]},
+		{"splunk.shc.captain.election.count", m.SplunkShcCaptainElectionCount.Enabled, api[`SplunkSHCCaptainInfo`]},
+		{"splunk.shc.replication.status", m.SplunkShcReplicationStatus.Enabled, api[`SplunkSHCMemberInfo`]},
+		{"splunk.shc.replication.pending.count", m.SplunkShcReplicationPendingCount.Enabled, api[`SplunkSHCCaptainMembers`]},
+		{"splunk.shc.artifact.replication.failures", m.SplunkShcArtifactReplicationFailures.Enabled, api[`SplunkSHCCaptainJobs`]},
+		{"splunk.deployment.clients.count", m.SplunkDeploymentClientsCount.Enabled, api[`SplunkDeploymentClients`]},
+		{"splunk.deployment.serverclass.clients", m.SplunkDeploymentServerclassClients.Enabled, api[`SplunkDeploymentClients`]},
+		{"splunk.server.cpu.usage.percent", m.SplunkServerCPUUsagePercent.Enabled, api[`SplunkHostwideUsage`]},
+		{"splunk.server.memory.usage.bytes", m.SplunkServerMemoryUsageBytes.Enabled, api[`SplunkHostwideUsage`]},
+		{"splunk.server.partition.used.bytes", m.SplunkServerPartitionUsedBytes.Enabled, api[`SplunkPartitionsSpace`]},
+		{"splunk.server.partition.free.bytes", m.SplunkServerPartitionFreeBytes.Enabled, api[`SplunkPartitionsSpace`]},
+		{"splunk.server.partition.capacity.bytes", m.SplunkServerPartitionCapacityBytes.Enabled, api[`SplunkPartitionsSpace`]},
+		{"splunk.process.cpu.percent", m.SplunkProcessCPUPercent.Enabled, api[`SplunkProcessUsage`]},
+		{"splunk.process.memory.bytes", m.SplunkProcessMemoryBytes.Enabled, api[`SplunkProcessUsage`]},
+		{"splunk.cluster.index.searchable", m.SplunkClusterIndexSearchable.Enabled, api[`SplunkClusterIndexes`]},
+		{"splunk.cluster.index.replicated.copies", m.SplunkClusterIndexReplicatedCopies.Enabled, api[`SplunkClusterIndexes`]},
+		{"splunk.cluster.fixup.pending.count", m.SplunkClusterFixupPendingCount.Enabled, api[`SplunkClusterIndexes`]},
+		{"splunk.cluster.peer.fixup.tasks", m.SplunkClusterPeerFixupTasks.Enabled, api[`SplunkClusterPeers`]},
+		{"splunk.cluster.peer.replication.queue.length", m.SplunkClusterPeerReplicationQueueLength.Enabled, api[`SplunkClusterPeers`]},
+		{"splunk.cluster.peer.status", m.SplunkClusterPeerStatus.Enabled, api[`SplunkClusterPeers`]},
+		{"splunk.cluster.replication.factor", m.SplunkClusterReplicationFactor.Enabled, api[`SplunkClusterConfig`]},
+		{"splunk.cluster.search.factor", m.SplunkClusterSearchFactor.Enabled, api[`SplunkClusterConfig`]},
+		{"splunk.cluster.replication.factor.met", m.SplunkClusterReplicationFactorMet.Enabled, api[`SplunkClusterGeneration`]},
+		{"splunk.cluster.search.factor.met", m.SplunkClusterSearchFactorMet.Enabled, api[`SplunkClusterGeneration`]},
+		{"splunk.hec.data.received.bytes", m.SplunkHecDataReceivedBytes.Enabled, searchJobsEndpoint},
+		{"splunk.hec.data.received.bytes", m.SplunkHecDataReceivedBytes.Enabled, api[`SplunkHECTokens`]},
+		{"splunk.hec.requests.count", m.SplunkHecRequestsCount.Enabled, searchJobsEndpoint},
+		{"splunk.hec.requests.count", m.SplunkHecRequestsCount.Enabled, api[`SplunkHECTokens`]},
+		{"splunk.hec.errors.count", m.SplunkHecErrorsCount.Enabled, searchJobsEndpoint},
+		{"splunk.hec.errors.count", m.SplunkHecErrorsCount.Enabled, api[`SplunkHECTokens`]},
+		{"splunk.hec.token.enabled", m.SplunkHecTokenEnabled.Enabled, api[`SplunkHECTokens`]},
+		{"splunk.searches.running.count", m.SplunkSearchesRunningCount.Enabled, api[`SplunkActiveSearchJobs`]},
+		{"splunk.searches.queued.count", m.SplunkSearchesQueuedCount.Enabled, api[`SplunkActiveSearchJobs`]},
+		{"splunk.searches.limit", m.SplunkSearchesLimit.Enabled, api[`SplunkSearchConcurrency`]},
+		{"splunk.searches.realtime.running.count", m.SplunkSearchesRealtimeRunningCount.Enabled, api[`SplunkActiveSearchJobs`]},
+		{"splunk.searches.realtime.limit", m.SplunkSearchesRealtimeLimit.Enabled, api[`SplunkSearchConcurrency`]},
+		{"splunk.scheduler.oldest.queued.seconds", m.SplunkSchedulerOldestQueuedSeconds.Enabled, api[`SplunkActiveSearchJobs`]},
+		{"splunk.datamodel.acceleration.percent", m.SplunkDatamodelAccelerationPercent.Enabled, api[`SplunkDataModelSummaries`]},
+		{"splunk.datamodel.acceleration.size.bytes", m.SplunkDatamodelAccelerationSizeBytes.Enabled, api[`SplunkDataModelSummaries`]},
+		{"splunk.forwarder.connections.count", m.SplunkForwarderConnectionsCount.Enabled, searchJobsEndpoint},
+		{"splunk.forwarder.data.received.bytes", m.SplunkForwarderDataReceivedBytes.Enabled, searchJobsEndpoint},
+		{"splunk.pipeline.cpu.seconds", m.SplunkPipelineCPUSeconds.Enabled, searchJobsEndpoint},
+		{"splunk.dispatch.artifact.count", m.SplunkDispatchArtifactCount.Enabled, api[`SplunkDispatchArtifacts`]},
+		{"splunk.dispatch.disk.used.bytes", m.SplunkDispatchDiskUsedBytes.Enabled, api[`SplunkDispatchArtifacts`]},
+		{"splunk.user.dispatch.quota.used", m.SplunkUserDispatchQuotaUsed.Enabled, api[`SplunkActiveSearchJobs`]},
+		{"splunk.user.dispatch.quota.used", m.SplunkUserDispatchQuotaUsed.Enabled, api[`SplunkUsers`]},
+		{"splunk.user.dispatch.quota.used", m.SplunkUserDispatchQuotaUsed.Enabled, api[`SplunkRoles`]},
+		{"splunk.user.dispatch.quota.limit", m.SplunkUserDispatchQuotaLimit.Enabled, api[`SplunkUsers`]},
+		{"splunk.user.dispatch.quota.limit", m.SplunkUserDispatchQuotaLimit.Enabled, api[`SplunkRoles`]},
+		{"splunk.smartstore.cache.hit.ratio", m.SplunkSmartstoreCacheHitRatio.Enabled, api[`SplunkCacheManager`]},
+		{"splunk.smartstore.upload.pending.count", m.SplunkSmartstoreUploadPendingCount.Enabled, api[`SplunkCacheManager`]},
+		{"splunk.smartstore.eviction.count", m.SplunkSmartstoreEvictionCount.Enabled, api[`SplunkCacheManager`]},
+		{"splunk.search.cache.hit.ratio", m.SplunkSearchCacheHitRatio.Enabled, api[`SplunkSearchResultsCache`]},
+	}
+}
+
+// The apiDict entry of the REST API endpoint each metric is scraped from, which endpoint_overrides
+// can replace. Search based metrics and metrics scraped from several endpoints are left out
+func overridableEndpoints(cfg *Config) map[string]string {
+	keys := make(map[string]string, len(apiDict))
+	for key, ept := range apiDict {
+		keys[ept] = key
+	}
+
+	bucketEvents := newBucketEventSource(&instanceScraper{splunkScraper: &splunkScraper{conf: cfg, api: apiDict}})
+	endpoints := make(map[string]string)
+	several := make(map[string]bool)
+	for _, me := range metricEndpoints(cfg.MetricsBuilderConfig.Metrics, bucketEvents, apiDict) {
+		key, ok := keys[me.endpoint]
+		if !ok {
+			continue
+		}
+		if seen, ok := endpoints[me.metric]; ok && seen != key {
+			several[me.metric] = true
+		}
+		endpoints[me.metric] = key
+	}
+
+	for metric := range several {
+		delete(endpoints, metric)
+	}
+	return endpoints
+}
+
+// Outcome of a test request to an endpoint an enabled metric is scraped from
+type endpointCheck struct {
+	instance string
+	metric   string
+	endpoint string
+	// status code of the response, 0 when the request failed
+	status int
+	err    error
+}
+
+// Send a test request to every endpoint the enabled metrics of each instance are scraped from.
+// Endpoints shared by several metrics are requested once per instance
+func (s *splunkScraper) checkEndpoints(ctx context.Context) []endpointCheck {
+	var checks []endpointCheck
+
+	for _, inst := range s.instances {
+		type outcome struct {
+			status int
+			err    error
+		}
+		requested := make(map[string]outcome)
+
+		for _, me := range metricEndpoints(s.conf.MetricsBuilderConfig.Metrics, inst.bucketEvents, s.api) {
+			if !me.enabled {
+				continue
+			}
+
+			ept := me.endpoint
+			if ept == searchJobsEndpoint {
+				ept = inst.splunkClient.jobsPath + "?output_mode=json&count=1"
+			}
+
+			o, ok := requested[ept]
+			if !ok {
+				o.status, o.err = inst.checkEndpoint(ctx, ept)
+				requested[ept] = o
+			}
+
+			checks = append(checks, endpointCheck{
+				instance: inst.instance.Name,
+				metric:   me.metric,
+				endpoint: ept,
+				status:   o.status,
+				err:      o.err,
+			})
+		}
+	}
+
+	return checks
+}
+
+// Request the endpoint and return the status code it answered with
+func (s *instanceScraper) checkEndpoint(ctx context.Context, ept string) (int, error) {
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		return 0, err
+	}
+	res.Body.Close()
+
+	return res.StatusCode, nil
+}
+
+// CheckEndpoints sends a test request to every endpoint the metrics enabled in cfg are scraped
+// from and writes a table of the metrics, the endpoints and the responses to w. A 403 usually
+// means the configured account lacks a capability and a 404 that the instance does not run the
+// feature behind the endpoint, which helps when onboarding a new deployment before the receiver
+// goes live. Search based metrics are checked against the search jobs endpoint
+func CheckEndpoints(ctx context.Context, cfg *Config, host component.Host, settings component.TelemetrySettings, w io.Writer) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	s := &splunkScraper{
+		settings: settings,
+		conf:     cfg,
+		api:      cfg.apiEndpoints(),
+	}
+	for _, inst := range cfg.instances() {
+		client, err := newSplunkEntClient(cfg.forInstance(inst), host, settings)
+		if err != nil {
+			return fmt.Errorf("instance %s: %w", inst.Name, err)
+		}
+		is := &instanceScraper{
+			splunkScraper: s,
+			instance:      inst,
+			splunkClient:  client,
+		}
+		is.bucketEvents = newBucketEventSource(is)
+		s.instances = append(s.instances, is)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "INSTANCE\tMETRIC\tENDPOINT\tRESULT")
+	for _, check := range s.checkEndpoints(ctx) {
+		result := fmt.Sprintf("%d %s", check.status, http.StatusText(check.status))
+		if check.err != nil {
+			result = check.err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", check.instance, check.metric, check.endpoint, result)
+	}
+
+	return tw.Flush()
+}
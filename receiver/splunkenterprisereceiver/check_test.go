@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
+)
+
+func TestCheckEndpoints(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	// a search head without any license pools the account may see
+	forbidding := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/services/licenser/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer forbidding.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.Instances = []InstanceConfig{
+		{Name: "indexer1", Endpoint: ts.URL},
+		{Name: "sh1", Endpoint: forbidding.URL},
+	}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkUp.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkLicensePoolUsedBytes.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkLicensePoolQuotaBytes.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkSchedulerSkippedCount.Enabled = true
+
+	var out bytes.Buffer
+	require.NoError(t, CheckEndpoints(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), &out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 9)
+	require.Equal(t, []string{"INSTANCE", "METRIC", "ENDPOINT", "RESULT"}, strings.Fields(lines[0]))
+	require.Equal(t, []string{"indexer1", "splunk.license.pool.used.bytes", apiDict[`SplunkLicensePools`], "200", "OK"}, strings.Fields(lines[2]))
+	require.Equal(t, []string{"indexer1", "splunk.scheduler.skipped.count", "/servicesNS/nobody/search/search/jobs/?output_mode=json&count=1", "200", "OK"},
+		strings.Fields(lines[4]))
+	require.Equal(t, []string{"sh1", "splunk.up", apiDict[`SplunkServerInfo`], "200", "OK"}, strings.Fields(lines[5]))
+	require.Equal(t, []string{"sh1", "splunk.license.pool.quota.bytes", apiDict[`SplunkLicensePools`], "403", "Forbidden"}, strings.Fields(lines[7]))
+}
@@ -4,42 +4,367 @@
 package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/rand"
 	"crypto/tls"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/zap"
+)
+
+// Rows requested per page of search results. Splunk returns 100 unless asked for more and never
+// more than the maxresultrows of limits.conf, 50000 by default
+const searchResultsPageSize = 1000
+
+const (
+	// search responses read as JSON, rows as objects keyed by field name
+	searchOutputModeJSON = "json"
+	// search responses read as XML, which Splunk answers with unless asked otherwise
+	searchOutputModeXML = "xml"
+)
+
+// User-Agent of every request unless the headers setting overrides it, so that gateways and the
+// deployment's own logs can tell the receiver's requests apart
+const defaultUserAgent = "opentelemetry-collector-contrib/splunkenterprisereceiver"
+
+// Redirects followed per request before giving up, as many as the default client follows
+const maxRedirects = 10
+
+// Port splunkd serves the REST API on unless its mgmtHostPort says otherwise
+const defaultManagementPort = "8089"
+
+var (
+	errFailedLogin     = errors.New("Failed to retrieve a session key")
+	errFailedJobDelete = errors.New("Failed to delete search job")
+	// the deployment turned our credentials down, which no retry is going to fix
+	errRejectedCredentials = errors.New("Credentials rejected")
+	// the deployment redirected us to a host other than the one configured
+	errCrossHostRedirect = errors.New("Refused to follow redirect to another host")
+	// too many logins in a row were rejected, we hold off logging in for a while
+	errLoginSuspended = errors.New("Logins suspended after repeated rejected credentials")
 )
 
 type splunkEntClient struct {
-	endpoint  *url.URL
-	client    *http.Client
-	basicAuth string
+	endpoint *url.URL
+	client   *http.Client
+	// '/servicesNS/<owner>/<app>/search/jobs/', the namespace searches are dispatched in
+	jobsPath string
+	// '/servicesNS/<owner>/<app>/saved/searches/', where saved searches are dispatched from
+	savedSearchesPath string
+	// value of the Authorization header, either basic auth or the configured token. Empty when an
+	// auth extension authorizes the requests instead
+	authHeader string
+	username   string
+	password   string
+	// nil when session keys are disabled, in which case every request uses authHeader
+	session *sessionKeyCache
+	// path session keys are obtained from, '/services/auth/login' unless configured otherwise
+	loginPath    string
+	maxRetries   int
+	retryBackoff time.Duration
+	// upper bound on how long a Retry-After header can make us wait
+	maxRetryAfter time.Duration
+	// earliest_time and latest_time dispatch parameters, empty when neither is configured
+	timeRange url.Values
+	// whether responses are asked for gzipped
+	compressResponses bool
+	// search_output_mode, searches are dispatched and read in JSON unless it is xml
+	searchOutputMode string
+	// search mode the built-in searches are dispatched in, Splunk's default when empty
+	adhocSearchLevel string
+	// whether requests are traced to break their duration down in the debug log
+	traceRequests bool
+	// set whenever the deployment answers a request, whatever the status code
+	responded *atomic.Bool
+	logger    *zap.Logger
+}
+
+// Holds the session key returned by the login path so we can avoid authenticating
+// every single request against the deployment
+type sessionKeyCache struct {
+	sync.Mutex
+	ttl     time.Duration
+	key     string
+	expires time.Time
+	// logins are suspended for cooldown once threshold logins in a row were rejected, so that
+	// credentials going bad don't turn every request into another login
+	threshold      int
+	cooldown       time.Duration
+	failures       int
+	suspendedUntil time.Time
+	// every login rejected since the client was created
+	rejected int64
+}
+
+// Changes how newSplunkEntClient builds a client
+type clientOption func(*clientOptions)
+
+type clientOptions struct {
+	// used in place of the one built from HTTPClientSettings when set
+	httpClient *http.Client
+}
+
+// Send every request through the given client in place of the one built from HTTPClientSettings,
+// e.g. to test against a fake transport or to dial through a SOCKS proxy. The client is used as is,
+// none of the transport, auth extension, header or redirect settings are applied to it
+func withHTTPClient(client *http.Client) clientOption {
+	return func(o *clientOptions) {
+		o.httpClient = client
+	}
 }
 
-func newSplunkEntClient(cfg *Config) splunkEntClient {
-	// tls party
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+func newSplunkEntClient(cfg *Config, h component.Host, s component.TelemetrySettings, opts ...clientOption) (*splunkEntClient, error) {
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
 	}
 
-	client := &http.Client{Transport: tr}
+	// the transport honours the tls settings, so both client certificates and custom
+	// CAs are supported
+	client := o.httpClient
+	if client == nil {
+		var err error
+		if client, err = newHTTPClient(cfg, h); err != nil {
+			return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+		}
+	}
 
 	endpoint, _ := url.Parse(cfg.Endpoint)
+	// every path we request lives under the prefix, e.g. when the API sits behind a reverse proxy
+	if prefix := strings.Trim(cfg.PathPrefix, "/"); prefix != "" {
+		endpoint = endpoint.JoinPath(prefix)
+	}
 
 	// build and encode our auth string. Do this work once to avoid rebuilding the
-	// auth header every time we make a new request
-	authString := fmt.Sprintf("%s:%s", cfg.Username, cfg.Password)
-	auth64 := base64.StdEncoding.EncodeToString([]byte(authString))
-	basicAuth := fmt.Sprintf("Basic %s", auth64)
+	// auth header every time we make a new request. Credentials are opaque so that they are
+	// redacted when the config is printed, they have to be converted back to be used
+	var authHeader string
+	switch {
+	case cfg.Auth != nil:
+		// the auth extension sets its own credentials on every request, e.g. an OAuth2 token for
+		// a gateway in front of the deployment, and keeps them fresh
+	case cfg.Token != "":
+		authHeader = fmt.Sprintf("Bearer %s", string(cfg.Token))
+	default:
+		authString := fmt.Sprintf("%s:%s", cfg.Username, string(cfg.Password))
+		auth64 := base64.StdEncoding.EncodeToString([]byte(authString))
+		authHeader = fmt.Sprintf("Basic %s", auth64)
+	}
+
+	// searches run in the namespace of the configured owner and app so that knowledge objects
+	// scoped to them resolve
+	owner, app := cfg.SearchOwner, cfg.SearchApp
+	if owner == "" {
+		owner = defaultSearchOwner
+	}
+	if app == "" {
+		app = defaultSearchApp
+	}
+	jobsPath := fmt.Sprintf("/servicesNS/%s/%s/search/jobs/", owner, app)
+	savedSearchesPath := fmt.Sprintf("/servicesNS/%s/%s/saved/searches/", owner, app)
+
+	loginPath := cfg.AuthLoginPath
+	if loginPath == "" {
+		loginPath = defaultAuthLoginPath
+	}
+
+	timeRange := url.Values{}
+	if cfg.SearchEarliestTime != "" {
+		timeRange.Set("earliest_time", cfg.SearchEarliestTime)
+	}
+	if cfg.SearchLatestTime != "" {
+		timeRange.Set("latest_time", cfg.SearchLatestTime)
+	}
+
+	// tokens are long lived already and cannot be exchanged for a session key, neither can the
+	// credentials of an auth extension
+	var session *sessionKeyCache
+	if cfg.SessionKeyTTL > 0 && cfg.Token == "" && cfg.Auth == nil {
+		session = &sessionKeyCache{
+			ttl:       cfg.SessionKeyTTL,
+			threshold: cfg.AuthFailureThreshold,
+			cooldown:  cfg.AuthFailureCooldown,
+		}
+	}
+
+	return &splunkEntClient{
+		client:            client,
+		endpoint:          endpoint,
+		jobsPath:          jobsPath,
+		savedSearchesPath: savedSearchesPath,
+		authHeader:        authHeader,
+		username:          cfg.Username,
+		password:          string(cfg.Password),
+		session:           session,
+		loginPath:         loginPath,
+		maxRetries:        cfg.MaxRequestRetries,
+		retryBackoff:      cfg.RequestRetryBackoff,
+		maxRetryAfter:     cfg.MaxSearchWaitTime,
+		timeRange:         timeRange,
+		compressResponses: cfg.CompressResponses,
+		searchOutputMode:  cfg.SearchOutputMode,
+		adhocSearchLevel:  cfg.AdhocSearchLevel,
+		traceRequests:     cfg.TraceRequests,
+		responded:         &atomic.Bool{},
+		logger:            s.Logger,
+	}, nil
+}
+
+// Set the credentials of the client on the request, unless an auth extension sets its own
+func (c *splunkEntClient) authorize(req *http.Request) {
+	if c.authHeader != "" {
+		req.Header.Set("Authorization", c.authHeader)
+	}
+}
+
+// Make a lightweight authenticated request to the server info endpoint ept. Returns an error
+// wrapping errRejectedCredentials when the deployment answers it with a 401 or a 403
+func (c *splunkEntClient) verifyConnection(ctx context.Context, ept string) error {
+	req, err := c.createAPIRequest(ctx, ept)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.makeRequest(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("%w: %s", errRejectedCredentials, res.Status)
+	}
+
+	return nil
+}
+
+// Build the HTTP client the way HTTPClientSettings.ToClient does so that every client setting
+// still applies. The transport has to be built here as ToClient wraps it before handing it over,
+// which leaves no way to route it through a proxy. Neither request compression, which the
+// management port doesn't accept, nor the instrumentation of our own requests are carried over
+func newHTTPClient(cfg *Config, h component.Host) (*http.Client, error) {
+	hcs := cfg.HTTPClientSettings
+
+	tlsCfg, err := hcs.TLSSetting.LoadTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// the default transport already honours HTTP_PROXY, HTTPS_PROXY and NO_PROXY. It would also
+	// ask for gzip behind our back, which is left to CompressResponses instead
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableCompression = true
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+	if tlsCfg != nil {
+		transport.TLSClientConfig = tlsCfg
+	}
+	if hcs.ReadBufferSize > 0 {
+		transport.ReadBufferSize = hcs.ReadBufferSize
+	}
+	if hcs.WriteBufferSize > 0 {
+		transport.WriteBufferSize = hcs.WriteBufferSize
+	}
+	if hcs.MaxIdleConns != nil {
+		transport.MaxIdleConns = *hcs.MaxIdleConns
+	}
+	if hcs.MaxIdleConnsPerHost != nil {
+		transport.MaxIdleConnsPerHost = *hcs.MaxIdleConnsPerHost
+	}
+	if hcs.MaxConnsPerHost != nil {
+		transport.MaxConnsPerHost = *hcs.MaxConnsPerHost
+	}
+	if hcs.IdleConnTimeout != nil {
+		transport.IdleConnTimeout = *hcs.IdleConnTimeout
+	}
+	transport.DisableKeepAlives = hcs.DisableKeepAlives
+
+	rt := http.RoundTripper(transport)
+
+	// authenticators go innermost so they see the request as it is sent
+	if hcs.Auth != nil {
+		ext := h.GetExtensions()
+		if ext == nil {
+			return nil, errors.New("extensions configuration not found")
+		}
+		authenticator, err := hcs.Auth.GetClientAuthenticator(ext)
+		if err != nil {
+			return nil, err
+		}
+		if rt, err = authenticator.RoundTripper(rt); err != nil {
+			return nil, err
+		}
+	}
+
+	// keys are canonicalized so that a configured user-agent replaces ours rather than racing it
+	headers := map[string]configopaque.String{"User-Agent": defaultUserAgent}
+	for k, v := range hcs.Headers {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+	rt = &headerRoundTripper{next: rt, headers: headers}
+
+	if hcs.CustomRoundTripper != nil {
+		if rt, err = hcs.CustomRoundTripper(rt); err != nil {
+			return nil, err
+		}
+	}
 
-	return splunkEntClient{
-		client:    client,
-		endpoint:  endpoint,
-		basicAuth: basicAuth,
+	return &http.Client{
+		Transport:     rt,
+		Timeout:       hcs.Timeout,
+		CheckRedirect: checkRedirect,
+	}, nil
+}
+
+// Redirects are only followed within the host of the endpoint. A load balancer sending us to
+// another host would have us hand it our credentials, or drop them along the way, so we refuse
+// to go there. Within the host the credentials of the original request go along
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if req.URL.Host != via[0].URL.Host {
+		return fmt.Errorf("%w from %s to %s", errCrossHostRedirect, via[0].URL.Host, req.URL.Host)
+	}
+	if auth := via[0].Header.Get("Authorization"); auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	return nil
+}
+
+// Sets the configured headers, and our User-Agent, on every request
+type headerRoundTripper struct {
+	next    http.RoundTripper
+	headers map[string]configopaque.String
+}
+
+func (h *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range h.headers {
+		req.Header.Set(k, string(v))
 	}
+	return h.next.RoundTrip(req)
 }
 
 // For running ad hoc searches only
@@ -47,11 +372,43 @@ func (c *splunkEntClient) createRequest(ctx context.Context, sr *searchResponse)
 	// Running searches via Splunk's REST API is a two step process: First you submit the job to run
 	// this returns a jobid which is then used in the second part to retrieve the search results
 	if sr.Jobid == nil {
-		path := "/services/search/jobs/"
-		url, _ := url.JoinPath(c.endpoint.String(), path)
+		var path string
+		var params []string
+		if sr.savedSearch != "" {
+			// saved searches run as they were saved, time range included
+			path = c.savedSearchesPath + url.PathEscape(sr.savedSearch) + "/dispatch"
+		} else {
+			// the time range goes in with the dispatch, where it applies to every search that doesn't
+			// set one of its own inline
+			// the job is dispatched under a SID of our own, which makes a retried dispatch land on
+			// the job the first attempt created rather than start another one
+			if sr.sid == "" {
+				sid, err := newSearchID()
+				if err != nil {
+					return nil, err
+				}
+				sr.sid = sid
+			}
+			path = c.jobsPath
+			params = append(params, sr.search, "id="+sr.sid)
+			if len(c.timeRange) > 0 {
+				params = append(params, c.timeRange.Encode())
+			}
+		}
+		if c.searchOutputMode != searchOutputModeXML {
+			params = append(params, "output_mode="+searchOutputModeJSON)
+		}
+		if _, builtin := searchDict[sr.name]; builtin && c.adhocSearchLevel != "" {
+			params = append(params, "adhoc_search_level="+c.adhocSearchLevel)
+		}
+		url := c.endpointURL(path)
 
 		// reader for the response data
-		data := strings.NewReader(sr.search)
+		data := strings.NewReader(strings.Join(params, "&"))
+
+		if sr.sid != "" {
+			ctx = context.WithValue(ctx, idempotentRequest{}, &requestAttempts{})
+		}
 
 		// return the build request, ready to be run by makeRequest
 		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, data)
@@ -60,13 +417,22 @@ func (c *splunkEntClient) createRequest(ctx context.Context, sr *searchResponse)
 		}
 
 		// Required headers
-		req.Header.Add("Authorization", c.basicAuth)
+		c.authorize(req)
 		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		c.acceptGzip(req)
 
 		return req, nil
 	}
-	path := fmt.Sprintf("/services/search/jobs/%s/results", *sr.Jobid)
-	url, _ := url.JoinPath(c.endpoint.String(), path)
+	// results are read a page at a time, from the first row not read yet
+	page := url.Values{
+		"count":  {strconv.Itoa(searchResultsPageSize)},
+		"offset": {strconv.Itoa(sr.offset)},
+	}
+	if c.searchOutputMode != searchOutputModeXML {
+		page.Set("output_mode", searchOutputModeJSON)
+	}
+	path := fmt.Sprintf("%s%s/results?%s", c.jobsPath, *sr.Jobid, page.Encode())
+	url := c.endpointURL(path)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -74,14 +440,83 @@ func (c *splunkEntClient) createRequest(ctx context.Context, sr *searchResponse)
 	}
 
 	// Required headers
-	req.Header.Add("Authorization", c.basicAuth)
+	c.authorize(req)
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	c.acceptGzip(req)
 
 	return req, nil
 }
 
+// Context key marking a request other than a GET as safe to retry, such as the dispatch of a search
+// under a SID of our own. The value may be a *requestAttempts to learn how the attempts went
+type idempotentRequest struct{}
+
+// How the attempts at sending an idempotent request went
+type requestAttempts struct {
+	// an attempt failed on a connection error or a 5xx, so the request may have taken effect even
+	// though no response says so
+	failed bool
+}
+
+// Whether an attempt at sending the request failed in a way that leaves it unknown whether it took effect
+func attemptFailed(req *http.Request) bool {
+	attempts, ok := req.Context().Value(idempotentRequest{}).(*requestAttempts)
+	return ok && attempts.failed
+}
+
+// A SID for a search job dispatched by the receiver, made up of the characters Splunk accepts in
+// the name of a job's dispatch directory
+func newSearchID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate search id: %w", err)
+	}
+	return "otel_" + hex.EncodeToString(b), nil
+}
+
+// Remove a finished (or abandoned) search job so its artifacts don't pile up in the search
+// head's dispatch directory
+func (c *splunkEntClient) deleteSearchJob(ctx context.Context, sid string) error {
+	path := c.jobsPath + sid
+	url := c.endpointURL(path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	// Required headers
+	c.authorize(req)
+
+	res, err := c.makeRequest(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w %s: %s", errFailedJobDelete, sid, res.Status)
+	}
+
+	return nil
+}
+
+// The URL of path, which may carry a query, on the management endpoint. It is built from the
+// parsed endpoint rather than pasted onto its string form so the host, e.g. a bracketed IPv6
+// literal such as [::1]:8089, is carried over untouched
+func (c *splunkEntClient) endpointURL(path string) string {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return c.endpoint.String() + path
+	}
+
+	u := c.endpoint.JoinPath(ref.EscapedPath())
+	u.RawQuery = ref.RawQuery
+	return u.String()
+}
+
 func (c *splunkEntClient) createAPIRequest(ctx context.Context, apiEndpoint string) (*http.Request, error) {
-	url := c.endpoint.String() + apiEndpoint
+	url := c.endpointURL(apiEndpoint)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -89,19 +524,405 @@ func (c *splunkEntClient) createAPIRequest(ctx context.Context, apiEndpoint stri
 	}
 
 	// Required headers
-	req.Header.Add("Authorization", c.basicAuth)
+	c.authorize(req)
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	c.acceptGzip(req)
 
 	return req, nil
 }
 
+// Ask for the response to be gzipped when configured to, makeRequest decompresses it
+func (c *splunkEntClient) acceptGzip(req *http.Request) {
+	if c.compressResponses {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+}
+
 // Construct and perform a request to the API. Returns the searchResponse passed into the
 // function as state
 func (c *splunkEntClient) makeRequest(req *http.Request) (*http.Response, error) {
-	res, err := c.client.Do(req)
+	res, err := c.makeAuthenticatedRequest(req)
 	if err != nil {
 		return nil, err
 	}
+	return gunzipResponse(res)
+}
+
+// Swap the body of a gzipped response for its decompressed content so that callers never see the
+// difference
+func gunzipResponse(res *http.Response) (*http.Response, error) {
+	if !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		return res, nil
+	}
+
+	zr, err := gzip.NewReader(res.Body)
+	switch {
+	case errors.Is(err, io.EOF):
+		// nothing was compressed, e.g. a 204 while a search is still running
+		res.Body.Close()
+		res.Body = http.NoBody
+	case err != nil:
+		res.Body.Close()
+		return nil, fmt.Errorf("failed to decompress response: %w", err)
+	default:
+		res.Body = &gzipBody{Reader: zr, body: res.Body}
+	}
+
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
 
 	return res, nil
 }
+
+// Decompresses a response body, closing it along with the reader
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (g *gzipBody) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
+
+// Perform the request with the cached session key when session keys are enabled, logging in again
+// once if the deployment no longer accepts it
+func (c *splunkEntClient) makeAuthenticatedRequest(req *http.Request) (*http.Response, error) {
+	if c.session == nil {
+		return c.do(req)
+	}
+
+	key, err := c.sessionKey(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Splunk "+key)
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusUnauthorized {
+		return res, nil
+	}
+
+	// the key expired or was revoked server side. Log in again and retry the request once
+	res.Body.Close()
+	c.invalidateSessionKey(key)
+
+	key, err = c.sessionKey(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	retry.Header.Set("Authorization", "Splunk "+key)
+
+	return c.do(retry)
+}
+
+// Send the request. GETs are idempotent so they are retried up to maxRetries times when they
+// fail on a connection error, a 429 or a 5xx, doubling the wait between attempts every time. So
+// are requests marked idempotentRequest, a retry sending the same body as the first attempt.
+// A 429 means the request was turned down before being processed, so whatever its method it
+// is retried at least once, after waiting as long as its Retry-After header asks for.
+// Any other response, including every other 4xx, is handed straight back to the caller
+func (c *splunkEntClient) do(req *http.Request) (*http.Response, error) {
+	retries := c.maxRetries
+	if req.Method != http.MethodGet && req.Context().Value(idempotentRequest{}) == nil {
+		retries = 0
+	}
+
+	res, err := c.roundTrip(req)
+
+	for attempt := 0; ; attempt++ {
+		if attempts, ok := req.Context().Value(idempotentRequest{}).(*requestAttempts); ok &&
+			(err != nil || res.StatusCode >= http.StatusInternalServerError) {
+			attempts.failed = true
+		}
+
+		rateLimited := err == nil && res.StatusCode == http.StatusTooManyRequests
+		if !(attempt < retries && retryable(res, err)) && !(attempt == 0 && rateLimited) {
+			return res, err
+		}
+
+		wait := c.retryBackoff << attempt
+		if rateLimited {
+			if after, ok := c.retryAfter(res); ok {
+				wait = after
+			}
+		}
+
+		if res != nil {
+			res.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		retry := req
+		if req.Body != nil && req.GetBody != nil {
+			retry = req.Clone(req.Context())
+			if retry.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err = c.roundTrip(retry)
+	}
+}
+
+// How long the Retry-After header of a response asks us to wait, bounded by maxRetryAfter. The
+// header holds either a number of seconds or an HTTP date
+func (c *splunkEntClient) retryAfter(res *http.Response) (time.Duration, bool) {
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		wait = time.Until(date)
+	} else {
+		return 0, false
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+	if c.maxRetryAfter > 0 && wait > c.maxRetryAfter {
+		wait = c.maxRetryAfter
+	}
+
+	return wait, true
+}
+
+// Send a single request, noting whether the deployment answered it. Every exchange, retries and
+// logins included, is logged at debug level. Neither headers nor bodies are logged since they
+// carry the credentials
+func (c *splunkEntClient) roundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	var timings *requestTimings
+	if c.traceRequests {
+		timings = &requestTimings{start: start}
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), timings.clientTrace()))
+	}
+	res, err := c.client.Do(req)
+
+	fields := []zap.Field{
+		zap.String("method", req.Method),
+		zap.String("path", req.URL.Path),
+		zap.Duration("duration", time.Since(start)),
+	}
+	if timings != nil {
+		fields = append(fields, timings.fields()...)
+	}
+	if err != nil {
+		c.logger.Debug("Request failed", append(fields, zap.Error(err))...)
+		return res, err
+	}
+	c.logger.Debug("Request completed", append(fields, zap.Int("status", res.StatusCode))...)
+
+	c.responded.Store(true)
+	return res, err
+}
+
+// How long each phase of a request took, captured through httptrace when trace_requests is set.
+// A request reusing a kept alive connection skips the DNS lookup, the connection and the TLS
+// handshake. The hooks can be called from other goroutines than the one sending the request
+type requestTimings struct {
+	sync.Mutex
+	start                            time.Time
+	dnsStart, connectStart, tlsStart time.Time
+	dns, connect, tls, firstByte     time.Duration
+	reused                           bool
+}
+
+func (t *requestTimings) clientTrace() *httptrace.ClientTrace {
+	// only the first connection attempt is timed, when several addresses are raced
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.Lock()
+			defer t.Unlock()
+			t.dnsStart = time.Now()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.Lock()
+			defer t.Unlock()
+			t.dns = time.Since(t.dnsStart)
+		},
+		ConnectStart: func(string, string) {
+			t.Lock()
+			defer t.Unlock()
+			if t.connectStart.IsZero() {
+				t.connectStart = time.Now()
+			}
+		},
+		ConnectDone: func(string, string, error) {
+			t.Lock()
+			defer t.Unlock()
+			if t.connect == 0 {
+				t.connect = time.Since(t.connectStart)
+			}
+		},
+		TLSHandshakeStart: func() {
+			t.Lock()
+			defer t.Unlock()
+			t.tlsStart = time.Now()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.Lock()
+			defer t.Unlock()
+			t.tls = time.Since(t.tlsStart)
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.Lock()
+			defer t.Unlock()
+			t.reused = info.Reused
+		},
+		GotFirstResponseByte: func() {
+			t.Lock()
+			defer t.Unlock()
+			t.firstByte = time.Since(t.start)
+		},
+	}
+}
+
+func (t *requestTimings) fields() []zap.Field {
+	t.Lock()
+	defer t.Unlock()
+	return []zap.Field{
+		zap.Duration("dns", t.dns),
+		zap.Duration("connect", t.connect),
+		zap.Duration("tls", t.tls),
+		zap.Duration("time_to_first_byte", t.firstByte),
+		zap.Bool("reused_connection", t.reused),
+	}
+}
+
+// Whether the outcome of a request is worth retrying
+func retryable(res *http.Response, err error) bool {
+	if err != nil {
+		// the deployment is going to redirect us the same way every time
+		return !errors.Is(err, errCrossHostRedirect)
+	}
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError
+}
+
+// Returns the cached session key, logging in first if we don't hold one or the one we
+// hold has outlived its ttl. No login is attempted while logins are suspended
+func (c *splunkEntClient) sessionKey(ctx context.Context) (string, error) {
+	c.session.Lock()
+	defer c.session.Unlock()
+
+	now := time.Now()
+	if c.session.key != "" && now.Before(c.session.expires) {
+		return c.session.key, nil
+	}
+	if now.Before(c.session.suspendedUntil) {
+		return "", fmt.Errorf("%w until %s", errLoginSuspended, c.session.suspendedUntil.Format(time.RFC3339))
+	}
+
+	key, err := c.login(ctx)
+	if errors.Is(err, errRejectedCredentials) {
+		c.session.rejected++
+		c.session.failures++
+		if c.session.threshold > 0 && c.session.failures >= c.session.threshold {
+			c.session.failures = 0
+			c.session.suspendedUntil = now.Add(c.session.cooldown)
+			c.logger.Warn("Suspending logins after repeated rejected credentials",
+				zap.Int("failures", c.session.threshold), zap.Duration("cooldown", c.session.cooldown))
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	c.session.failures = 0
+	c.session.key = key
+	c.session.expires = time.Now().Add(c.session.ttl)
+
+	return key, nil
+}
+
+// Number of logins rejected since the client was created, always 0 without session keys
+func (c *splunkEntClient) rejectedLogins() int64 {
+	if c.session == nil {
+		return 0
+	}
+
+	c.session.Lock()
+	defer c.session.Unlock()
+	return c.session.rejected
+}
+
+// Drops the cached session key unless another request already replaced it
+func (c *splunkEntClient) invalidateSessionKey(key string) {
+	c.session.Lock()
+	defer c.session.Unlock()
+
+	if c.session.key == key {
+		c.session.key = ""
+	}
+}
+
+// Exchange the configured credentials for a new session key
+func (c *splunkEntClient) login(ctx context.Context) (string, error) {
+	var lr loginResponse
+
+	data := url.Values{
+		"username": {c.username},
+		"password": {c.password},
+	}
+
+	url := c.endpointURL(c.loginPath)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.roundTrip(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		return "", fmt.Errorf("%w: %w: %s", errFailedLogin, errRejectedCredentials, res.Status)
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w: %s", errFailedLogin, res.Status)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read response: %w", err)
+	}
+
+	err = xml.Unmarshal(body, &lr)
+	if err != nil {
+		return "", fmt.Errorf("Failed to unmarshall response: %w", err)
+	}
+
+	if lr.SessionKey == "" {
+		return "", errFailedLogin
+	}
+
+	return lr.SessionKey, nil
+}
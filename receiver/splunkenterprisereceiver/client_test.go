@@ -4,23 +4,42 @@
 package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/extension/auth"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestClientCreation(t *testing.T) {
 	// create a client from an example config
-	client := newSplunkEntClient(&Config{
+	client, err := newSplunkEntClient(&Config{
 		Username:          "admin",
 		Password:          "securityFirst",
 		MaxSearchWaitTime: 11 * time.Second,
@@ -31,7 +50,8 @@ func TestClientCreation(t *testing.T) {
 			CollectionInterval: 10 * time.Second,
 			InitialDelay:       1 * time.Second,
 		},
-	})
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
 
 	testEndpoint, _ := url.Parse("https://localhost:8089")
 
@@ -40,14 +60,27 @@ func TestClientCreation(t *testing.T) {
 	testBasicAuth := fmt.Sprintf("Basic %s", auth64)
 
 	require.Equal(t, client.endpoint, testEndpoint)
-	require.Equal(t, client.basicAuth, testBasicAuth)
+	require.Equal(t, client.authHeader, testBasicAuth)
+
+	// tokens are sent as is and never exchanged for a session key
+	client, err = newSplunkEntClient(&Config{
+		Token:         "eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig",
+		SessionKeyTTL: time.Minute,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://localhost:8089",
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	require.Equal(t, "Bearer eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig", client.authHeader)
+	require.Nil(t, client.session)
 }
 
 // test functionality of createRequest which is used for building metrics out of
 // ad-hoc searches
 func TestClientCreateRequest(t *testing.T) {
 	// create a client from an example config
-	client := newSplunkEntClient(&Config{
+	client, err := newSplunkEntClient(&Config{
 		Username:          "admin",
 		Password:          "securityFirst",
 		MaxSearchWaitTime: 11 * time.Second,
@@ -58,30 +91,68 @@ func TestClientCreateRequest(t *testing.T) {
 			CollectionInterval: 10 * time.Second,
 			InitialDelay:       1 * time.Second,
 		},
-	})
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	// same deployment, searching from within another app and reading results as XML
+	appClient, err := newSplunkEntClient(&Config{
+		Username:         "admin",
+		Password:         "securityFirst",
+		SearchOwner:      "admin",
+		SearchApp:        "license_app",
+		SearchOutputMode: searchOutputModeXML,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://localhost:8089",
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	// same deployment, searching the day up to a license reset at 06:00
+	rangeClient, err := newSplunkEntClient(&Config{
+		Username:           "admin",
+		Password:           "securityFirst",
+		SearchEarliestTime: "-1d@d+6h",
+		SearchLatestTime:   "@d+6h",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://localhost:8089",
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	// same deployment, dispatching the built-in searches in fast mode
+	levelClient, err := newSplunkEntClient(&Config{
+		Username:         "admin",
+		Password:         "securityFirst",
+		AdhocSearchLevel: "fast",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://localhost:8089",
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
 
 	testJobID := "123"
 
 	tests := []struct {
 		desc     string
 		sr       *searchResponse
-		client   splunkEntClient
+		client   *splunkEntClient
 		expected *http.Request
 	}{
 		{
 			desc: "First req, no jobid",
 			sr: &searchResponse{
 				search: "example search",
+				sid:    "otel_test",
 			},
 			client: client,
 			expected: func() *http.Request {
 				method := "POST"
-				path := "/services/search/jobs/"
+				path := "/servicesNS/nobody/search/search/jobs/"
 				testEndpoint, _ := url.Parse("https://localhost:8089")
 				url, _ := url.JoinPath(testEndpoint.String(), path)
-				data := strings.NewReader("example search")
+				data := strings.NewReader("example search&id=otel_test&output_mode=json")
 				req, _ := http.NewRequest(method, url, data)
-				req.Header.Add("Authorization", client.basicAuth)
+				req.Header.Add("Authorization", client.authHeader)
 				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 				return req
 			}(),
@@ -95,11 +166,107 @@ func TestClientCreateRequest(t *testing.T) {
 			client: client,
 			expected: func() *http.Request {
 				method := "GET"
-				path := fmt.Sprintf("/services/search/jobs/%s/results", testJobID)
+				path := fmt.Sprintf("/servicesNS/nobody/search/search/jobs/%s/results", testJobID)
 				testEndpoint, _ := url.Parse("https://localhost:8089")
 				url, _ := url.JoinPath(testEndpoint.String(), path)
+				url += "?count=1000&offset=0&output_mode=json"
 				req, _ := http.NewRequest(method, url, nil)
-				req.Header.Add("Authorization", client.basicAuth)
+				req.Header.Add("Authorization", client.authHeader)
+				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+				return req
+			}(),
+		},
+		{
+			desc: "Custom namespace",
+			sr: &searchResponse{
+				search: "example search",
+				sid:    "otel_test",
+			},
+			client: appClient,
+			expected: func() *http.Request {
+				method := "POST"
+				path := "/servicesNS/admin/license_app/search/jobs/"
+				testEndpoint, _ := url.Parse("https://localhost:8089")
+				url, _ := url.JoinPath(testEndpoint.String(), path)
+				data := strings.NewReader("example search&id=otel_test")
+				req, _ := http.NewRequest(method, url, data)
+				req.Header.Add("Authorization", appClient.authHeader)
+				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+				return req
+			}(),
+		},
+		{
+			desc: "Time range",
+			sr: &searchResponse{
+				search: "example search",
+				sid:    "otel_test",
+			},
+			client: rangeClient,
+			expected: func() *http.Request {
+				method := "POST"
+				path := "/servicesNS/nobody/search/search/jobs/"
+				testEndpoint, _ := url.Parse("https://localhost:8089")
+				url, _ := url.JoinPath(testEndpoint.String(), path)
+				data := strings.NewReader("example search&id=otel_test&earliest_time=-1d%40d%2B6h&latest_time=%40d%2B6h&output_mode=json")
+				req, _ := http.NewRequest(method, url, data)
+				req.Header.Add("Authorization", rangeClient.authHeader)
+				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+				return req
+			}(),
+		},
+		{
+			desc: "Built-in search level",
+			sr: &searchResponse{
+				name:   `SplunkLicenseIndexUsageSearch`,
+				search: "example search",
+				sid:    "otel_test",
+			},
+			client: levelClient,
+			expected: func() *http.Request {
+				method := "POST"
+				path := "/servicesNS/nobody/search/search/jobs/"
+				testEndpoint, _ := url.Parse("https://localhost:8089")
+				url, _ := url.JoinPath(testEndpoint.String(), path)
+				data := strings.NewReader("example search&id=otel_test&output_mode=json&adhoc_search_level=fast")
+				req, _ := http.NewRequest(method, url, data)
+				req.Header.Add("Authorization", levelClient.authHeader)
+				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+				return req
+			}(),
+		},
+		{
+			desc: "Custom search level",
+			sr: &searchResponse{
+				name:   "splunk.license.index.usage",
+				search: "example search",
+				sid:    "otel_test",
+			},
+			client: levelClient,
+			expected: func() *http.Request {
+				method := "POST"
+				path := "/servicesNS/nobody/search/search/jobs/"
+				testEndpoint, _ := url.Parse("https://localhost:8089")
+				url, _ := url.JoinPath(testEndpoint.String(), path)
+				data := strings.NewReader("example search&id=otel_test&output_mode=json")
+				req, _ := http.NewRequest(method, url, data)
+				req.Header.Add("Authorization", levelClient.authHeader)
+				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+				return req
+			}(),
+		},
+		{
+			desc: "Saved search dispatch",
+			sr: &searchResponse{
+				name:        "splunk.license.index.usage",
+				savedSearch: "license usage",
+			},
+			client: levelClient,
+			expected: func() *http.Request {
+				method := "POST"
+				testEndpoint, _ := url.Parse("https://localhost:8089/servicesNS/nobody/search/saved/searches/license%20usage/dispatch")
+				data := strings.NewReader("output_mode=json")
+				req, _ := http.NewRequest(method, testEndpoint.String(), data)
+				req.Header.Add("Authorization", levelClient.authHeader)
 				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 				return req
 			}(),
@@ -120,9 +287,41 @@ func TestClientCreateRequest(t *testing.T) {
 	}
 }
 
+// an ad hoc search is dispatched again under the SID generated for its first dispatch
+func TestSearchIDReused(t *testing.T) {
+	client, err := newSplunkEntClient(&Config{
+		Username: "admin",
+		Password: "securityFirst",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://localhost:8089",
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	sr := &searchResponse{search: "search=search index=_internal"}
+	var sids []string
+	for i := 0; i < 2; i++ {
+		req, err := client.createRequest(ctx, sr)
+		require.NoError(t, err)
+		require.NoError(t, req.ParseForm())
+		require.NotNil(t, req.Context().Value(idempotentRequest{}))
+		sids = append(sids, req.PostForm.Get("id"))
+	}
+	require.Regexp(t, `^otel_[0-9a-f]{24}$`, sids[0])
+	require.Equal(t, sids[0], sids[1])
+
+	// saved searches are dispatched without an id, so their dispatch is never retried
+	req, err := client.createRequest(ctx, &searchResponse{savedSearch: "license usage"})
+	require.NoError(t, err)
+	require.NoError(t, req.ParseForm())
+	require.Empty(t, req.PostForm.Get("id"))
+	require.Nil(t, req.Context().Value(idempotentRequest{}))
+}
+
 // createAPIRequest creates a request for api calls i.e. to introspection endpoint
 func TestAPIRequestCreate(t *testing.T) {
-	client := newSplunkEntClient(&Config{
+	client, err := newSplunkEntClient(&Config{
 		Username:          "admin",
 		Password:          "securityFirst",
 		MaxSearchWaitTime: 11 * time.Second,
@@ -133,7 +332,8 @@ func TestAPIRequestCreate(t *testing.T) {
 			CollectionInterval: 10 * time.Second,
 			InitialDelay:       1 * time.Second,
 		},
-	})
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
 
 	ctx := context.Background()
 	req, err := client.createAPIRequest(ctx, "/test/endpoint")
@@ -141,7 +341,7 @@ func TestAPIRequestCreate(t *testing.T) {
 
 	expectedURL := client.endpoint.String() + "/test/endpoint"
 	expected, _ := http.NewRequest(http.MethodGet, expectedURL, nil)
-	expected.Header.Add("Authorization", client.basicAuth)
+	expected.Header.Add("Authorization", client.authHeader)
 	expected.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
 	require.Equal(t, expected.URL, req.URL)
@@ -149,3 +349,956 @@ func TestAPIRequestCreate(t *testing.T) {
 	require.Equal(t, expected.Header, req.Header)
 	require.Equal(t, expected.Body, req.Body)
 }
+
+// every request goes through the path prefix when one is configured
+func TestPathPrefix(t *testing.T) {
+	client, err := newSplunkEntClient(&Config{
+		Username:   "admin",
+		Password:   "securityFirst",
+		PathPrefix: "/splunk/",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://proxy.example.com",
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	req, err := client.createAPIRequest(ctx, "/services/server/info?output_mode=json")
+	require.NoError(t, err)
+	require.Equal(t, "https://proxy.example.com/splunk/services/server/info?output_mode=json", req.URL.String())
+
+	req, err = client.createRequest(ctx, &searchResponse{search: "search=search index=_internal"})
+	require.NoError(t, err)
+	require.Equal(t, "https://proxy.example.com/splunk/servicesNS/nobody/search/search/jobs/", req.URL.String())
+}
+
+// bracketed IPv6 endpoints keep their host through every request built
+func TestIPv6Endpoint(t *testing.T) {
+	cfg := &Config{
+		Username: "admin",
+		Password: "securityFirst",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://[::1]:8089",
+		},
+	}
+	require.Equal(t, "[::1]:8089", cfg.instances()[0].Name)
+
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	req, err := client.createAPIRequest(ctx, "/services/server/info?output_mode=json")
+	require.NoError(t, err)
+	require.Equal(t, "https://[::1]:8089/services/server/info?output_mode=json", req.URL.String())
+	require.Equal(t, "::1", req.URL.Hostname())
+	require.Equal(t, "8089", req.URL.Port())
+
+	req, err = client.createRequest(ctx, &searchResponse{search: "search=search index=_internal"})
+	require.NoError(t, err)
+	require.Equal(t, "https://[::1]:8089/servicesNS/nobody/search/search/jobs/", req.URL.String())
+
+	jobid := "1234"
+	req, err = client.createRequest(ctx, &searchResponse{Jobid: &jobid})
+	require.NoError(t, err)
+	require.Equal(t, "https://[::1]:8089/servicesNS/nobody/search/search/jobs/1234/results?count=1000&offset=0&output_mode=json", req.URL.String())
+
+	// and reach a server listening on the IPv6 loopback, where the host has one
+	ln, err := net.Listen("tcp6", "[::1]:0")
+	if err != nil {
+		t.Skipf("IPv6 loopback unavailable: %v", err)
+	}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.Listener = ln
+	srv.Start()
+	defer srv.Close()
+
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+	client, err = newSplunkEntClient(&Config{
+		Username: "admin",
+		Password: "securityFirst",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "http://" + net.JoinHostPort("::1", port),
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	req, err = client.createAPIRequest(ctx, "/services/server/info")
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+// makeRequest should log in once, reuse the session key and log in again only
+// once the server stops accepting the key it holds
+func TestSessionKeyAuth(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		logins int
+	)
+
+	validKey := func() string {
+		return fmt.Sprintf("key%d", logins)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/services/auth/login":
+			require.NoError(t, r.ParseForm())
+			if r.Form.Get("username") != "admin" || r.Form.Get("password") != "securityFirst" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			logins++
+			_, _ = w.Write([]byte(fmt.Sprintf("<response><sessionKey>%s</sessionKey></response>", validKey())))
+		case "/services/revoke":
+			// simulate the server expiring the key before our ttl is up
+			logins++
+		default:
+			if r.Header.Get("Authorization") != "Splunk "+validKey() {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := newSplunkEntClient(&Config{
+		Username:      "admin",
+		Password:      "securityFirst",
+		SessionKeyTTL: time.Hour,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	doRequest := func() int {
+		req, err := client.createAPIRequest(ctx, "/services/server/info")
+		require.NoError(t, err)
+		res, err := client.makeRequest(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		return res.StatusCode
+	}
+
+	require.Equal(t, http.StatusOK, doRequest())
+	require.Equal(t, http.StatusOK, doRequest())
+	require.Equal(t, 1, logins)
+
+	res, err := http.Get(ts.URL + "/services/revoke")
+	require.NoError(t, err)
+	res.Body.Close()
+
+	// the stale key is rejected with a 401, the client logs in again and retries
+	require.Equal(t, http.StatusOK, doRequest())
+	require.Equal(t, 3, logins)
+	require.Equal(t, "key3", client.session.key)
+}
+
+// session keys come from the configured login path, under the path prefix like every other path
+func TestAuthLoginPath(t *testing.T) {
+	var logins []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/auth/login") {
+			logins = append(logins, r.URL.Path)
+			if r.URL.Path != "/splunk/sso/auth/login" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`<response><sessionKey>192fd3e46a31246da7ea7f109e7f95fd</sessionKey></response>`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Splunk 192fd3e46a31246da7ea7f109e7f95fd" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client, err := newSplunkEntClient(&Config{
+		Username:      "admin",
+		Password:      "securityFirst",
+		SessionKeyTTL: time.Hour,
+		PathPrefix:    "/splunk",
+		AuthLoginPath: "/sso/auth/login",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	req, err := client.createAPIRequest(context.Background(), "/services/server/info")
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, []string{"/splunk/sso/auth/login"}, logins)
+}
+
+func TestLoginSuspended(t *testing.T) {
+	var logins atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/auth/login" {
+			logins.Add(1)
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	client, err := newSplunkEntClient(&Config{
+		Username:             "admin",
+		Password:             "rotated",
+		SessionKeyTTL:        time.Hour,
+		AuthFailureThreshold: 2,
+		AuthFailureCooldown:  time.Hour,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	doRequest := func() error {
+		req, err := client.createAPIRequest(context.Background(), "/services/server/info")
+		require.NoError(t, err)
+		res, err := client.makeRequest(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		require.ErrorIs(t, doRequest(), errRejectedCredentials)
+	}
+
+	// the threshold is reached, requests fail without another login
+	for i := 0; i < 3; i++ {
+		require.ErrorIs(t, doRequest(), errLoginSuspended)
+	}
+	require.EqualValues(t, 2, logins.Load())
+	require.EqualValues(t, 2, client.rejectedLogins())
+
+	// once the cooldown is over logging in is attempted again
+	client.session.suspendedUntil = time.Now()
+	require.ErrorIs(t, doRequest(), errRejectedCredentials)
+	require.EqualValues(t, 3, logins.Load())
+	require.EqualValues(t, 3, client.rejectedLogins())
+}
+
+func TestMakeRequestRetries(t *testing.T) {
+	var hits int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		switch r.URL.Path {
+		case "/flaky":
+			// unavailable twice before recovering
+			if hits <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		case "/ratelimited":
+			// turned down once, asking for a wait far longer than we are willing to give it
+			if hits == 1 {
+				w.Header().Set("Retry-After", "3600")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := newSplunkEntClient(&Config{
+		Username:            "admin",
+		Password:            "securityFirst",
+		MaxRequestRetries:   2,
+		RequestRetryBackoff: time.Millisecond,
+		MaxSearchWaitTime:   10 * time.Millisecond,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc         string
+		method       string
+		path         string
+		idempotent   bool
+		expectStatus int
+		expectHits   int
+	}{
+		{
+			desc:         "5xx retried until success",
+			method:       http.MethodGet,
+			path:         "/flaky",
+			expectStatus: http.StatusOK,
+			expectHits:   3,
+		},
+		{
+			desc:         "4xx fails fast",
+			method:       http.MethodGet,
+			path:         "/forbidden",
+			expectStatus: http.StatusForbidden,
+			expectHits:   1,
+		},
+		{
+			desc:         "retries are bounded",
+			method:       http.MethodGet,
+			path:         "/down",
+			expectStatus: http.StatusBadGateway,
+			expectHits:   3,
+		},
+		{
+			desc:         "non idempotent requests are not retried",
+			method:       http.MethodPost,
+			path:         "/down",
+			expectStatus: http.StatusBadGateway,
+			expectHits:   1,
+		},
+		{
+			desc:         "idempotent posts are retried",
+			method:       http.MethodPost,
+			path:         "/down",
+			idempotent:   true,
+			expectStatus: http.StatusBadGateway,
+			expectHits:   3,
+		},
+		{
+			desc:         "429 retried once whatever the method",
+			method:       http.MethodPost,
+			path:         "/ratelimited",
+			expectStatus: http.StatusOK,
+			expectHits:   2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			hits = 0
+			ctx := context.Background()
+			if test.idempotent {
+				ctx = context.WithValue(ctx, idempotentRequest{}, true)
+			}
+			req, err := http.NewRequestWithContext(ctx, test.method, ts.URL+test.path, nil)
+			require.NoError(t, err)
+
+			res, err := client.makeRequest(req)
+			require.NoError(t, err)
+			res.Body.Close()
+
+			require.Equal(t, test.expectStatus, res.StatusCode)
+			require.Equal(t, test.expectHits, hits)
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	client := &splunkEntClient{maxRetryAfter: time.Minute}
+
+	tests := []struct {
+		desc   string
+		header string
+		expect time.Duration
+		ok     bool
+	}{
+		{desc: "missing", header: ""},
+		{desc: "seconds", header: "5", expect: 5 * time.Second, ok: true},
+		{desc: "bounded", header: "3600", expect: time.Minute, ok: true},
+		{desc: "date in the past", header: "Wed, 21 Oct 2015 07:28:00 GMT", expect: 0, ok: true},
+		{desc: "garbage", header: "soon"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if test.header != "" {
+				res.Header.Set("Retry-After", test.header)
+			}
+			wait, ok := client.retryAfter(res)
+			require.Equal(t, test.ok, ok)
+			require.Equal(t, test.expect, wait)
+		})
+	}
+
+	// dates in the future are waited out until they are reached
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", time.Now().Add(30*time.Second).UTC().Format(http.TimeFormat))
+	wait, ok := client.retryAfter(res)
+	require.True(t, ok)
+	require.InDelta(t, 30*time.Second, wait, float64(2*time.Second))
+}
+
+// the transport is built from the tls settings, here a private CA and a client certificate
+func TestClientTLS(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	// the test server's own certificate doubles as the CA and the client certificate
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	key, err := x509.MarshalPKCS8PrivateKey(ts.TLS.Certificates[0].PrivateKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0600))
+
+	doRequest := func(tlsSetting configtls.TLSClientSetting) (*http.Response, error) {
+		client, err := newSplunkEntClient(&Config{
+			Username: "admin",
+			Password: "securityFirst",
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Endpoint:   ts.URL,
+				TLSSetting: tlsSetting,
+			},
+		}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+		require.NoError(t, err)
+
+		req, err := client.createAPIRequest(context.Background(), "/services/server/info")
+		require.NoError(t, err)
+		return client.makeRequest(req)
+	}
+
+	// unknown CA
+	_, err = doRequest(configtls.TLSClientSetting{})
+	require.Error(t, err)
+
+	res, err := doRequest(configtls.TLSClientSetting{
+		TLSSetting: configtls.TLSSetting{
+			CAFile:   caFile,
+			CertFile: caFile,
+			KeyFile:  keyFile,
+		},
+	})
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// no client certificate
+	res, err = doRequest(configtls.TLSClientSetting{InsecureSkipVerify: true})
+	if err == nil {
+		res.Body.Close()
+	}
+	require.Error(t, err)
+}
+
+// every request carries our User-Agent and the configured headers, which can replace it
+func TestClientHeaders(t *testing.T) {
+	var got []http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Clone())
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<response><sid>1234</sid></response>`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		Username: "admin",
+		Password: "securityFirst",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+			Headers:  map[string]configopaque.String{"X-Audit-Team": "observability"},
+		},
+	}
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	req, err := client.createAPIRequest(ctx, "/services/server/info")
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	req, err = client.createRequest(ctx, &searchResponse{search: "search=search index=_internal"})
+	require.NoError(t, err)
+	res, err = client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	require.Len(t, got, 2)
+	for _, h := range got {
+		require.Equal(t, "opentelemetry-collector-contrib/splunkenterprisereceiver", h.Get("User-Agent"))
+		require.Equal(t, "observability", h.Get("X-Audit-Team"))
+	}
+
+	got = nil
+	cfg.Headers = map[string]configopaque.String{"user-agent": "gateway-probe/1.0"}
+	client, err = newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	req, err = client.createAPIRequest(ctx, "/services/server/info")
+	require.NoError(t, err)
+	res, err = client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, "gateway-probe/1.0", got[0].Get("User-Agent"))
+}
+
+// every exchange is logged at debug level, without anything giving the credentials away
+func TestClientDebugLogging(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/auth/login" {
+			_, _ = w.Write([]byte(`<response><sessionKey>192fd3e46a31246da7ea7f109e7f95fd</sessionKey></response>`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	core, logs := observer.New(zap.DebugLevel)
+	settings := componenttest.NewNopTelemetrySettings()
+	settings.Logger = zap.New(core)
+
+	client, err := newSplunkEntClient(&Config{
+		Username:      "admin",
+		Password:      "securityFirst",
+		SessionKeyTTL: time.Minute,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+	}, componenttest.NewNopHost(), settings)
+	require.NoError(t, err)
+
+	req, err := client.createAPIRequest(context.Background(), "/services/server/info?output_mode=json")
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	entries := logs.All()
+	require.Len(t, entries, 2)
+	for i, path := range []string{"/services/auth/login", "/services/server/info"} {
+		fields := entries[i].ContextMap()
+		require.Equal(t, zap.DebugLevel, entries[i].Level)
+		require.Equal(t, path, fields["path"])
+		require.Contains(t, fields, "duration")
+		for _, v := range fields {
+			require.NotContains(t, fmt.Sprint(v), "securityFirst")
+			require.NotContains(t, fmt.Sprint(v), "192fd3e46a31246da7ea7f109e7f95fd")
+		}
+	}
+	require.Equal(t, int64(http.StatusNotFound), entries[1].ContextMap()["status"])
+
+	// nothing is logged above debug level
+	core, logs = observer.New(zap.InfoLevel)
+	client.logger = zap.New(core)
+	req, err = client.createAPIRequest(context.Background(), "/services/server/info?output_mode=json")
+	require.NoError(t, err)
+	res, err = client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Zero(t, logs.Len())
+}
+
+// traced requests break their duration down, which untraced ones leave out
+func TestClientTraceRequests(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	core, logs := observer.New(zap.DebugLevel)
+	settings := componenttest.NewNopTelemetrySettings()
+	settings.Logger = zap.New(core)
+
+	cfg := &Config{
+		Username: "admin",
+		Password: "securityFirst",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint:   ts.URL,
+			TLSSetting: configtls.TLSClientSetting{InsecureSkipVerify: true},
+		},
+	}
+	doRequest := func(client *splunkEntClient) map[string]any {
+		req, err := client.createAPIRequest(context.Background(), "/services/server/info")
+		require.NoError(t, err)
+		res, err := client.makeRequest(req)
+		require.NoError(t, err)
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		entries := logs.TakeAll()
+		require.Len(t, entries, 1)
+		return entries[0].ContextMap()
+	}
+
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), settings)
+	require.NoError(t, err)
+	fields := doRequest(client)
+	for _, name := range []string{"dns", "connect", "tls", "time_to_first_byte", "reused_connection"} {
+		require.NotContains(t, fields, name)
+	}
+
+	cfg.TraceRequests = true
+	client, err = newSplunkEntClient(cfg, componenttest.NewNopHost(), settings)
+	require.NoError(t, err)
+
+	fields = doRequest(client)
+	require.Equal(t, false, fields["reused_connection"])
+	require.Greater(t, fields["connect"], time.Duration(0))
+	require.Greater(t, fields["tls"], time.Duration(0))
+	require.Greater(t, fields["time_to_first_byte"], time.Duration(0))
+
+	// the connection is kept alive, so there is nothing left to time but the exchange
+	fields = doRequest(client)
+	require.Equal(t, true, fields["reused_connection"])
+	require.Equal(t, time.Duration(0), fields["connect"])
+	require.Equal(t, time.Duration(0), fields["tls"])
+	require.Greater(t, fields["time_to_first_byte"], time.Duration(0))
+}
+
+func TestClientRedirects(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer other.Close()
+
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/services/server/info":
+			http.Redirect(w, r, "/splunk/services/server/info", http.StatusFound)
+		case "/splunk/services/server/info":
+			if r.Header.Get("Authorization") != "Bearer eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/services/server/status":
+			http.Redirect(w, r, other.URL+"/services/server/status", http.StatusFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := newSplunkEntClient(&Config{
+		Token:             "eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig",
+		MaxRequestRetries: 2,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	// within the host the credentials go along
+	req, err := client.createAPIRequest(context.Background(), "/services/server/info")
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// another host is refused outright, retrying would not change a thing
+	requests.Store(0)
+	req, err = client.createAPIRequest(context.Background(), "/services/server/status")
+	require.NoError(t, err)
+	_, err = client.makeRequest(req)
+	require.ErrorIs(t, err, errCrossHostRedirect)
+	require.EqualValues(t, 1, requests.Load())
+}
+
+// the transport sits under the round tripper setting our headers
+func transportOf(t *testing.T, c *splunkEntClient) *http.Transport {
+	headers, ok := c.client.Transport.(*headerRoundTripper)
+	require.True(t, ok)
+	transport, ok := headers.next.(*http.Transport)
+	require.True(t, ok)
+	return transport
+}
+
+func TestClientIdleConns(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.Endpoint = "https://localhost:8089"
+
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	transport := transportOf(t, client)
+	require.Equal(t, 100, transport.MaxIdleConns)
+	require.Equal(t, 10, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+	require.False(t, transport.DisableKeepAlives)
+
+	maxIdleConnsPerHost := 2
+	idleConnTimeout := time.Minute
+	cfg.MaxIdleConnsPerHost = &maxIdleConnsPerHost
+	cfg.IdleConnTimeout = &idleConnTimeout
+	cfg.DisableKeepAlives = true
+
+	client, err = newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	transport = transportOf(t, client)
+	require.Equal(t, 2, transport.MaxIdleConnsPerHost)
+	require.Equal(t, time.Minute, transport.IdleConnTimeout)
+	require.True(t, transport.DisableKeepAlives)
+}
+
+func TestClientProxy(t *testing.T) {
+	cfg := &Config{
+		Username: "admin",
+		Password: "securityFirst",
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "https://localhost:8089",
+		},
+	}
+
+	// without a proxy url the environment decides
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	transport := transportOf(t, client)
+	require.NotNil(t, transport.Proxy)
+
+	cfg.ProxyURL = "http://proxy.internal:3128"
+	client, err = newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	transport = transportOf(t, client)
+
+	req, err := client.createAPIRequest(context.Background(), "/services/server/info")
+	require.NoError(t, err)
+	proxy, err := transport.Proxy(req)
+	require.NoError(t, err)
+	require.Equal(t, "http://proxy.internal:3128", proxy.String())
+}
+
+// requests actually go through the proxy, which sees the deployment as the target
+func TestClientProxyRequest(t *testing.T) {
+	var target string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	client, err := newSplunkEntClient(&Config{
+		Username: "admin",
+		Password: "securityFirst",
+		ProxyURL: proxy.URL,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "http://splunk.internal:8089",
+		},
+	}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	req, err := client.createAPIRequest(context.Background(), "/services/server/info")
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "http://splunk.internal:8089/services/server/info", target)
+}
+
+func TestClientCompressResponses(t *testing.T) {
+	const body = `{"entry":[{"name":"main","content":{"totalEventCount":"1532"}}]}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		_, _ = zw.Write([]byte(body))
+		_ = zw.Close()
+	}))
+	defer ts.Close()
+
+	for _, compress := range []bool{false, true} {
+		client, err := newSplunkEntClient(&Config{
+			Username:          "admin",
+			Password:          "securityFirst",
+			CompressResponses: compress,
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Endpoint: ts.URL,
+			},
+		}, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+		require.NoError(t, err)
+
+		req, err := client.createAPIRequest(context.Background(), "/services/data/indexes")
+		require.NoError(t, err)
+		res, err := client.makeRequest(req)
+		require.NoError(t, err)
+
+		// gzipped or not, callers get the plain body
+		got, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		require.Equal(t, body, string(got))
+		require.Equal(t, compress, res.Uncompressed)
+	}
+}
+
+// host serving the given extensions
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// sets the token the way the oauth2client extension does, on the request as it is sent
+type bearerRoundTripper struct {
+	next  http.RoundTripper
+	token string
+}
+
+func (b *bearerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+b.token)
+	return b.next.RoundTrip(req)
+}
+
+// with an auth extension set, its credentials authorize every request and no login is attempted
+func TestClientAuthExtension(t *testing.T) {
+	var got []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.Path+" "+r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	oauth2 := component.NewID("oauth2client")
+	host := &extensionsHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			oauth2: auth.NewClient(auth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+				return &bearerRoundTripper{next: base, token: "gateway-token"}, nil
+			})),
+		},
+	}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Auth = &configauth.Authentication{AuthenticatorID: oauth2}
+	require.NoError(t, cfg.Validate())
+
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.Nil(t, client.session)
+
+	req, err := client.createAPIRequest(context.Background(), apiDict[`SplunkServerInfo`])
+	require.NoError(t, err)
+	require.Empty(t, req.Header.Get("Authorization"))
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	require.Equal(t, []string{"/services/server/info Bearer gateway-token"}, got)
+
+	// the extension stands in for every other credential
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	require.ErrorIs(t, cfg.Validate(), errConflictingAuth)
+}
+
+// records the requests it is handed and answers them itself instead of sending them
+type recordingRoundTripper struct {
+	got []string
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.got = append(r.got, req.Method+" "+req.URL.Path+" "+req.Header.Get("Authorization"))
+	body := "{}"
+	if req.URL.Path == defaultAuthLoginPath {
+		body = `<response><sessionKey>192fd3e46a31246da7ea7f109e7f95fd</sessionKey></response>`
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// an injected http.Client carries every request in place of the one built from the config
+func TestClientInjectedHTTPClient(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "https://splunk.example.com:8089"
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	require.NoError(t, cfg.Validate())
+
+	rt := &recordingRoundTripper{}
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), withHTTPClient(&http.Client{Transport: rt}))
+	require.NoError(t, err)
+
+	req, err := client.createAPIRequest(context.Background(), apiDict[`SplunkServerInfo`])
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	// the login goes through the injected client as well
+	require.Equal(t, []string{
+		"POST " + defaultAuthLoginPath + " ",
+		"GET /services/server/info Splunk 192fd3e46a31246da7ea7f109e7f95fd",
+	}, rt.got)
+}
+
+// opaque credentials go out as they were configured, not redacted
+func TestClientCredentialsSent(t *testing.T) {
+	var got []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	basic := createDefaultConfig().(*Config)
+	basic.Endpoint = ts.URL
+	basic.Username = "admin"
+	basic.Password = "securityFirst"
+	basic.SessionKeyTTL = 0
+
+	token := createDefaultConfig().(*Config)
+	token.Endpoint = ts.URL
+	token.Token = "eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig"
+
+	for _, cfg := range []*Config{basic, token} {
+		require.NoError(t, cfg.Validate())
+		client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+		require.NoError(t, err)
+
+		req, err := client.createAPIRequest(context.Background(), apiDict[`SplunkServerInfo`])
+		require.NoError(t, err)
+		res, err := client.makeRequest(req)
+		require.NoError(t, err)
+		res.Body.Close()
+	}
+
+	require.Equal(t, []string{
+		"Basic " + base64.StdEncoding.EncodeToString([]byte("admin:securityFirst")),
+		"Bearer eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig",
+	}, got)
+}
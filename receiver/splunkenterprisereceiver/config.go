@@ -5,11 +5,14 @@ package splunkenterprisereceiver // import "github.com/open-telemetry/openteleme
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
 
@@ -21,45 +24,497 @@ var (
 	errMissingUsername      = errors.New("Missing valid username")
 	errMissingPassword      = errors.New("Missing valid password")
 	errBadScheme            = errors.New("Endpoint scheme must be either http or https")
+	errBadConcurrency       = errors.New("Max concurrent searches must be greater than zero")
+	errBadSessionKeyTTL     = errors.New("Session key ttl must not be negative")
+	errBadAuthFailures      = errors.New("Auth failure threshold and cooldown must not be negative")
+	errBadResultsTimeout    = errors.New("Results read timeout must not be negative")
+	errBadPollInterval      = errors.New("Max search poll interval must be greater than zero")
+	errBadSearchPoll        = errors.New("Search poll interval must be greater than zero and less than max search wait time")
+	errEmptySavedSearch     = errors.New("Saved search names must not be empty")
+	errEmptySourcetype      = errors.New("Source type names must not be empty")
+	errEmptyApp             = errors.New("App names must not be empty")
+	errEmptyUser            = errors.New("User names must not be empty")
+	errBadRetries           = errors.New("Max request retries must not be negative")
+	errBadRetryBackoff      = errors.New("Request retry backoff must not be negative")
+	errBadPathPrefix        = errors.New("Path prefix must be a plain url path")
+	errBadAuthLoginPath     = errors.New("Auth login path must be a plain url path")
+	errConflictingAuth      = errors.New("Only one of username and password, token or an auth extension can be set")
+	errConflictingEndpoints = errors.New("Only one of endpoint or instances can be set")
+	errDuplicateInstance    = errors.New("Instance names must be unique")
+	errUnknownCustomSearch  = errors.New("Custom searches can only replace the search of a search based metric")
+	errEmptyCustomSearch    = errors.New("Custom searches must not be empty")
+	errConflictingSearch    = errors.New("Custom searches take only one of search and saved_search_name")
+	errBadProxyURL          = errors.New("Proxy url must be an http, https or socks5 url")
+	errBlankSearchTime      = errors.New("Search earliest and latest time must not be blank")
+	errBadBucketEvents      = errors.New("Bucket events source must be either search or api")
+	errBadSearchOutputMode  = errors.New("Search output mode must be either json or xml")
+	errBadAdhocSearchLevel  = errors.New("Adhoc search level must be one of fast, smart or verbose")
+	errEmptyLicenseField    = errors.New("License index and bytes fields must not be empty")
+	errUnknownOverride      = errors.New("Endpoint overrides can only replace the endpoint of a metric scraped from a single REST API endpoint")
+	errBadOverride          = errors.New("Endpoint overrides must be plain url paths")
+	errConflictingOverride  = errors.New("Metrics scraped from the same endpoint must not override it with different paths")
+	errBadLicenseIndex      = errors.New("License usage indexes must be index names made of letters, digits, underscores, hyphens or wildcards")
+	errBadLatencyStatistic  = errors.New("Ingestion latency statistic must be avg, median, max or a percentile from p1 to p99")
+	errBadIndexRegex        = errors.New("Index include and exclude regexes must compile")
 )
 
+// Splunk index names, which keep the clause scoping the license usage search to them intact
+var licenseIndexPattern = regexp.MustCompile(`^[a-zA-Z0-9_*-]+$`)
+
+// Compiles index_include_regex or index_exclude_regex, nil when it is not set
+func compileIndexRegex(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w, got %q: %w", errBadIndexRegex, expr, err)
+	}
+	return re, nil
+}
+
+// stats functions ingestion_latency_statistic can name
+var latencyStatisticPattern = regexp.MustCompile(`^(avg|median|max|p[1-9][0-9]?)$`)
+
 type Config struct {
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
 	// Username and password with associated with an account with
 	// permission to access the Splunk deployments REST api
-	Username string `mapstructure:"username"`
-	Password string `mapstructure:"password"`
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// Base path prepended to every REST API path, for deployments whose
+	// management port sits behind a reverse proxy
+	PathPrefix string `mapstructure:"path_prefix"`
+	// Proxy every request to the deployment goes through, in place of the
+	// one set by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+	ProxyURL string `mapstructure:"proxy_url"`
+	// Splunk authentication token sent as a bearer token instead
+	// of a username and password
+	Token configopaque.String `mapstructure:"token"`
 	// default is 60s
 	MaxSearchWaitTime time.Duration `mapstructure:"max_search_wait_time"`
+	// First wait between polls of a running search job. default is 200ms
+	SearchPollInterval time.Duration `mapstructure:"search_poll_interval"`
+	// Upper bound on the exponentially growing wait between polls
+	// of a running search job. default is 5s
+	MaxSearchPollInterval time.Duration `mapstructure:"max_search_poll_interval"`
+	// Timeout of every request for the results of a finished search job, reading
+	// the results included. 0 sets no timeout. default is 30s
+	ResultsReadTimeout time.Duration `mapstructure:"results_read_timeout"`
+	// Upper bound on the number of searches and API requests run against
+	// the deployment at the same time during a scrape. default is 4
+	MaxConcurrentSearches int `mapstructure:"max_concurrent_searches"`
+	// How long a session key obtained from auth_login_path is reused
+	// before logging in again. 0 sends basic auth with every request. default is 30m
+	SessionKeyTTL time.Duration `mapstructure:"session_key_ttl"`
+	// Path session keys are obtained from, for deployments proxying the login
+	// endpoint elsewhere. path_prefix applies to it as to every other path.
+	// default is /services/auth/login
+	AuthLoginPath string `mapstructure:"auth_login_path"`
+	// Consecutive logins turned down with a 401 or a 403 after which logging in is suspended
+	// for auth_failure_cooldown. 0 never suspends logins. default is 3
+	AuthFailureThreshold int `mapstructure:"auth_failure_threshold"`
+	// How long logging in stays suspended once auth_failure_threshold is reached. default is 5m
+	AuthFailureCooldown time.Duration `mapstructure:"auth_failure_cooldown"`
+	// Number of times a GET or a search dispatch failing on a connection error,
+	// a 429 or a 5xx is retried. default is 2
+	MaxRequestRetries int `mapstructure:"max_request_retries"`
+	// Wait before the first retry of a request, doubled for every retry
+	// after that. default is 1s
+	RequestRetryBackoff time.Duration `mapstructure:"request_retry_backoff"`
+	// Owner and app of the namespace searches are dispatched in. default
+	// is nobody and search
+	SearchOwner string `mapstructure:"search_owner"`
+	SearchApp   string `mapstructure:"search_app"`
+	// Time range searches are dispatched with, in Splunk's relative time syntax,
+	// e.g. -24h@h. Searches with a time range of their own inline keep it.
+	// default is all time
+	SearchEarliestTime string `mapstructure:"search_earliest_time"`
+	SearchLatestTime   string `mapstructure:"search_latest_time"`
+	// Search mode the built-in searches are dispatched in, one of fast, smart or
+	// verbose. Custom searches run in the mode Splunk defaults to. default is fast
+	AdhocSearchLevel string `mapstructure:"adhoc_search_level"`
+	// Fields of the license usage search results holding the index name and
+	// the bytes indexed, for summary indexes naming them differently from the
+	// built-in search. default is indexname and By
+	LicenseIndexField string `mapstructure:"license_index_field"`
+	LicenseBytesField string `mapstructure:"license_bytes_field"`
+	// Indexes whose license usage the built-in license usage search reads, to
+	// keep it from scanning the usage of every index. default is all
+	LicenseUsageIndexes []string `mapstructure:"license_usage_indexes"`
+	// Whether license usage and source type throughput are read from the summaries
+	// kept for the Monitoring Console with tstats instead of searching the raw logs.
+	// default is false
+	UseMonitoringConsole bool `mapstructure:"use_monitoring_console"`
+	// Saved searches reported by the per saved search metrics. These can
+	// have very high cardinality so it is recommended to set this. default is all
+	SavedSearches []string `mapstructure:"saved_searches"`
+	// Source types reported by splunk.sourcetype.event.count and
+	// splunk.indexer.ingestion.latency.seconds. Every source type ever indexed is
+	// counted so it is recommended to set this. default is all
+	Sourcetypes []string `mapstructure:"sourcetypes"`
+	// Function aggregating the ingestion latency of the events of a source type:
+	// avg, median, max or a percentile such as p95. default is avg
+	IngestionLatencyStatistic string `mapstructure:"ingestion_latency_statistic"`
+	// Apps reported by splunk.search.count and whose triggered alerts the
+	// splunk.alerts.triggered metrics count. default is all
+	Apps []string `mapstructure:"apps"`
+	// Users reported by the splunk.user.dispatch.quota metrics. default is all
+	Users []string `mapstructure:"users"`
+	// Regexes the names of the indexes reported by the metrics of the index list
+	// must and must not match. Exclusion wins over inclusion. default is all
+	IndexIncludeRegex string `mapstructure:"index_include_regex"`
+	IndexExcludeRegex string `mapstructure:"index_exclude_regex"`
+	// Where splunk.index.buckets.rolled.count and splunk.index.buckets.frozen.count
+	// come from: search counts them in splunkd.log, api estimates them from
+	// the bucket counts of every index. default is search
+	BucketEventsSource string `mapstructure:"bucket_events_source"`
+	// Format search results are read in, either json or xml. xml is kept for
+	// deployments relying on how earlier versions read results. default is json
+	SearchOutputMode string `mapstructure:"search_output_mode"`
+	// Whether responses are asked for gzipped. Off by default as some proxies
+	// mishandle compressed responses
+	CompressResponses bool `mapstructure:"compress_responses"`
+	// Whether the debug log of every request breaks its duration down into DNS lookup,
+	// connection, TLS handshake and time to first byte. default is false
+	TraceRequests bool `mapstructure:"trace_requests"`
+	// Whether start sends every instance an authenticated request so that
+	// rejected credentials fail the receiver right away. default is true
+	VerifyConnectionOnStart bool `mapstructure:"verify_connection_on_start"`
+	// Splunk instances scraped by the receiver, in place of endpoint. Every
+	// other setting, including the credentials above, is shared by all of them
+	Instances []InstanceConfig `mapstructure:"instances"`
+	// REST API paths, query included, replacing the default endpoint of a metric, keyed by
+	// metric name, for Splunk versions serving it elsewhere. Every metric scraped from the
+	// same endpoint shares the override
+	EndpointOverrides map[string]string `mapstructure:"endpoint_overrides"`
+	// Searches computing search based metrics in place of their built-in
+	// search, keyed by metric name
+	CustomSearches map[string]CustomSearch `mapstructure:"custom_searches"`
+}
+
+// A search a search based metric is computed from in place of its built-in search. Its results
+// must hold one row per datapoint, with the attribute field of the built-in search
+type CustomSearch struct {
+	// SPL of the search, as it would be typed into the search bar
+	Search string `mapstructure:"search"`
+	// Name of a saved search of search_app dispatched in place of
+	// an ad hoc search, for deployments where the SPL they run must be
+	// approved beforehand. Only one of search and saved_search_name can be set
+	SavedSearchName string `mapstructure:"saved_search_name"`
+	// Field of the results holding the value of the metric. default is the
+	// field of the built-in search
+	Field string `mapstructure:"field"`
+}
+
+// A Splunk instance scraped alongside others by the same receiver
+type InstanceConfig struct {
+	// Value of the splunk.instance resource attribute. default is the host and
+	// port of the endpoint
+	Name     string `mapstructure:"name"`
+	Endpoint string `mapstructure:"endpoint"`
+	// Credentials of this instance. When none of them are set the ones shared
+	// by every instance are used
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	Token    configopaque.String `mapstructure:"token"`
+	// Skip verification of the certificate of this instance only, e.g. while its
+	// certificate is being replaced. Every other instance is verified as configured
+	InsecureSkipVerify bool `mapstructure:"insecure_skip_verify"`
+}
+
+// The instances to scrape, with names and shared credentials filled in. Without any instances
+// configured the receiver scrapes the single instance at endpoint
+func (cfg *Config) instances() []InstanceConfig {
+	instances := cfg.Instances
+	if len(instances) == 0 {
+		instances = []InstanceConfig{{Endpoint: cfg.Endpoint}}
+	}
+
+	resolved := make([]InstanceConfig, 0, len(instances))
+	for _, inst := range instances {
+		inst.Endpoint = endpointWithScheme(inst.Endpoint)
+		if inst.Username == "" && inst.Password == "" && inst.Token == "" {
+			inst.Username, inst.Password, inst.Token = cfg.Username, cfg.Password, cfg.Token
+		}
+		if inst.Name == "" {
+			inst.Name = inst.Endpoint
+			if u, err := url.Parse(inst.Endpoint); err == nil && u.Host != "" {
+				inst.Name = u.Host
+			}
+		}
+		resolved = append(resolved, inst)
+	}
+
+	return resolved
+}
+
+// Endpoints given without a scheme, e.g. localhost:8089, are reached over https as the management
+// port only serves http once SSL has been turned off on it
+func endpointWithScheme(endpoint string) string {
+	if endpoint == "" || strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	return "https://" + endpoint
+}
+
+// A copy of the config pointing at the instance, which is what the client of that instance is built from
+func (cfg *Config) forInstance(inst InstanceConfig) *Config {
+	c := *cfg
+	c.Endpoint = inst.Endpoint
+	c.Username = inst.Username
+	c.Password = inst.Password
+	c.Token = inst.Token
+	if inst.InsecureSkipVerify {
+		c.TLSSetting.InsecureSkipVerify = true
+	}
+	return &c
+}
+
+// apiDict with the paths of EndpointOverrides in place of the default ones
+func (cfg *Config) apiEndpoints() map[string]string {
+	api := make(map[string]string, len(apiDict))
+	for key, ept := range apiDict {
+		api[key] = ept
+	}
+
+	if len(cfg.EndpointOverrides) == 0 {
+		return api
+	}
+	keys := overridableEndpoints(cfg)
+	for metric, path := range cfg.EndpointOverrides {
+		if key, ok := keys[metric]; ok {
+			api[key] = path
+		}
+	}
+	return api
 }
 
 func (cfg *Config) Validate() (errors error) {
-	var targetURL *url.URL
+	if cfg.Endpoint != "" && len(cfg.Instances) > 0 {
+		errors = multierr.Append(errors, errConflictingEndpoints)
+	}
+
+	names := make(map[string]bool)
+	for _, inst := range cfg.instances() {
+		err := validateInstance(inst, cfg.Auth != nil)
+		if names[inst.Name] {
+			err = multierr.Append(err, errDuplicateInstance)
+		}
+		names[inst.Name] = true
+
+		// the errors of a lone instance configured through endpoint need no telling apart
+		if err != nil && len(cfg.Instances) > 0 {
+			err = fmt.Errorf("instance %s: %w", inst.Name, err)
+		}
+		errors = multierr.Append(errors, err)
+	}
+
+	if cfg.PathPrefix != "" {
+		prefix, err := url.Parse(cfg.PathPrefix)
+		if err != nil || prefix.Scheme != "" || prefix.Host != "" || prefix.RawQuery != "" || prefix.Fragment != "" {
+			errors = multierr.Append(errors, errBadPathPrefix)
+		}
+	}
+
+	if cfg.AuthLoginPath != "" {
+		login, err := url.Parse(cfg.AuthLoginPath)
+		if err != nil || !strings.HasPrefix(cfg.AuthLoginPath, "/") || login.Scheme != "" || login.Host != "" ||
+			login.RawQuery != "" || login.Fragment != "" {
+			errors = multierr.Append(errors, errBadAuthLoginPath)
+		}
+	}
+
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil || (proxy.Scheme != "http" && proxy.Scheme != "https" && proxy.Scheme != "socks5") || proxy.Host == "" {
+			errors = multierr.Append(errors, errBadProxyURL)
+		}
+	}
+
+	if cfg.MaxSearchPollInterval <= 0 {
+		errors = multierr.Append(errors, errBadPollInterval)
+	}
+
+	if cfg.MaxConcurrentSearches <= 0 {
+		errors = multierr.Append(errors, errBadConcurrency)
+	}
+
+	// the first poll has to happen before we give up on the search
+	if cfg.SearchPollInterval <= 0 || cfg.SearchPollInterval >= cfg.MaxSearchWaitTime {
+		errors = multierr.Append(errors, fmt.Errorf("%w, got %s and %s", errBadSearchPoll, cfg.SearchPollInterval, cfg.MaxSearchWaitTime))
+	}
+
+	if cfg.ResultsReadTimeout < 0 {
+		errors = multierr.Append(errors, errBadResultsTimeout)
+	}
+
+	if cfg.SessionKeyTTL < 0 {
+		errors = multierr.Append(errors, errBadSessionKeyTTL)
+	}
 
-	if cfg.Endpoint == "" {
+	if cfg.AuthFailureThreshold < 0 || cfg.AuthFailureCooldown < 0 {
+		errors = multierr.Append(errors, errBadAuthFailures)
+	}
+
+	if cfg.MaxRequestRetries < 0 {
+		errors = multierr.Append(errors, errBadRetries)
+	}
+
+	if cfg.RequestRetryBackoff < 0 {
+		errors = multierr.Append(errors, errBadRetryBackoff)
+	}
+
+	switch cfg.BucketEventsSource {
+	case "", bucketEventsSourceSearch, bucketEventsSourceAPI:
+	default:
+		errors = multierr.Append(errors, fmt.Errorf("%w, got %q", errBadBucketEvents, cfg.BucketEventsSource))
+	}
+
+	switch cfg.SearchOutputMode {
+	case "", searchOutputModeJSON, searchOutputModeXML:
+	default:
+		errors = multierr.Append(errors, fmt.Errorf("%w, got %q", errBadSearchOutputMode, cfg.SearchOutputMode))
+	}
+
+	switch cfg.AdhocSearchLevel {
+	case "", "fast", "smart", "verbose":
+	default:
+		errors = multierr.Append(errors, fmt.Errorf("%w, got %q", errBadAdhocSearchLevel, cfg.AdhocSearchLevel))
+	}
+
+	// a blank time doesn't fall back to the default, Splunk rejects it
+	for _, t := range []string{cfg.SearchEarliestTime, cfg.SearchLatestTime} {
+		if t != "" && strings.TrimSpace(t) == "" {
+			errors = multierr.Append(errors, errBlankSearchTime)
+			break
+		}
+	}
+
+	if strings.TrimSpace(cfg.LicenseIndexField) == "" || strings.TrimSpace(cfg.LicenseBytesField) == "" {
+		errors = multierr.Append(errors, errEmptyLicenseField)
+	}
+
+	for _, name := range cfg.LicenseUsageIndexes {
+		if !licenseIndexPattern.MatchString(name) {
+			errors = multierr.Append(errors, fmt.Errorf("%w, got %q", errBadLicenseIndex, name))
+			break
+		}
+	}
+
+	if cfg.IngestionLatencyStatistic != "" && !latencyStatisticPattern.MatchString(cfg.IngestionLatencyStatistic) {
+		errors = multierr.Append(errors, fmt.Errorf("%w, got %q", errBadLatencyStatistic, cfg.IngestionLatencyStatistic))
+	}
+
+	for _, name := range cfg.SavedSearches {
+		if name == "" {
+			errors = multierr.Append(errors, errEmptySavedSearch)
+			break
+		}
+	}
+
+	for _, name := range cfg.Sourcetypes {
+		if name == "" {
+			errors = multierr.Append(errors, errEmptySourcetype)
+			break
+		}
+	}
+
+	for _, name := range cfg.Apps {
+		if name == "" {
+			errors = multierr.Append(errors, errEmptyApp)
+			break
+		}
+	}
+
+	for _, name := range cfg.Users {
+		if name == "" {
+			errors = multierr.Append(errors, errEmptyUser)
+			break
+		}
+	}
+
+	for _, expr := range []string{cfg.IndexIncludeRegex, cfg.IndexExcludeRegex} {
+		if _, err := compileIndexRegex(expr); err != nil {
+			errors = multierr.Append(errors, err)
+		}
+	}
+
+	for name, cs := range cfg.CustomSearches {
+		if _, ok := searchMetrics[name]; !ok {
+			errors = multierr.Append(errors, fmt.Errorf("%w, got %s", errUnknownCustomSearch, name))
+			continue
+		}
+		switch search, saved := strings.TrimSpace(cs.Search), strings.TrimSpace(cs.SavedSearchName); {
+		case search == "" && saved == "":
+			errors = multierr.Append(errors, fmt.Errorf("%w, got one for %s", errEmptyCustomSearch, name))
+		case search != "" && saved != "":
+			errors = multierr.Append(errors, fmt.Errorf("%w, got both for %s", errConflictingSearch, name))
+		}
+	}
+
+	if len(cfg.EndpointOverrides) > 0 {
+		keys := overridableEndpoints(cfg)
+		overridden := make(map[string]string)
+		for metric, path := range cfg.EndpointOverrides {
+			key, ok := keys[metric]
+			if !ok {
+				errors = multierr.Append(errors, fmt.Errorf("%w, got %s", errUnknownOverride, metric))
+				continue
+			}
+			ept, err := url.Parse(path)
+			if err != nil || !strings.HasPrefix(path, "/") || ept.Scheme != "" || ept.Host != "" || ept.Fragment != "" {
+				errors = multierr.Append(errors, fmt.Errorf("%w, got %s for %s", errBadOverride, path, metric))
+				continue
+			}
+			if other, ok := overridden[key]; ok && other != path {
+				errors = multierr.Append(errors, fmt.Errorf("%w, got %s and %s", errConflictingOverride, other, path))
+			}
+			overridden[key] = path
+		}
+	}
+
+	return errors
+}
+
+// Validate the endpoint and credentials of a single instance
+func validateInstance(inst InstanceConfig, authExtension bool) (errors error) {
+	if inst.Endpoint == "" {
 		errors = multierr.Append(errors, errBadOrMissingEndpoint)
 	} else {
 		// we want to validate that the endpoint url supplied by user is at least
 		// a little bit valid
-		var err error
-		targetURL, err = url.Parse(cfg.Endpoint)
-		if err != nil {
+		targetURL, err := url.Parse(inst.Endpoint)
+		switch {
+		case err != nil:
 			errors = multierr.Append(errors, errBadOrMissingEndpoint)
-		}
-
-		if !strings.HasPrefix(targetURL.Scheme, "http") {
+		case !strings.HasPrefix(targetURL.Scheme, "http"):
 			errors = multierr.Append(errors, errBadScheme)
+		case targetURL.Host == "":
+			errors = multierr.Append(errors, errBadOrMissingEndpoint)
 		}
 	}
 
-	if cfg.Username == "" {
-		errors = multierr.Append(errors, errMissingUsername)
-	}
+	// exactly one of username and password, token or an auth extension authenticates us
+	switch {
+	case authExtension:
+		if inst.Username != "" || inst.Password != "" || inst.Token != "" {
+			errors = multierr.Append(errors, errConflictingAuth)
+		}
+	case inst.Token != "":
+		if inst.Username != "" || inst.Password != "" {
+			errors = multierr.Append(errors, errConflictingAuth)
+		}
+	default:
+		if inst.Username == "" {
+			errors = multierr.Append(errors, errMissingUsername)
+		}
 
-	if cfg.Password == "" {
-		errors = multierr.Append(errors, errMissingPassword)
+		if inst.Password == "" {
+			errors = multierr.Append(errors, errMissingPassword)
+		}
 	}
 
 	return errors
@@ -4,6 +4,8 @@
 package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
 
 import (
+	"bytes"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -13,9 +15,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
 )
@@ -25,7 +30,7 @@ func TestValidateConfig(t *testing.T) {
 
 	var multipleErrors error
 
-	multipleErrors = multierr.Combine(multipleErrors, errBadOrMissingEndpoint, errMissingUsername, errMissingPassword)
+	multipleErrors = multierr.Combine(multipleErrors, errBadOrMissingEndpoint, errMissingUsername, errMissingPassword, errBadPollInterval, errBadConcurrency)
 
 	tests := []struct {
 		desc   string
@@ -52,6 +57,19 @@ func TestValidateConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc:   "Token and username",
+			expect: errConflictingAuth,
+			conf: Config{
+				Username:              "admin",
+				Token:                 "eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
 		{
 			desc:   "Bad scheme (none http/s)",
 			expect: errBadScheme,
@@ -59,7 +77,42 @@ func TestValidateConfig(t *testing.T) {
 				Password: "securityFirst",
 				Username: "admin",
 				HTTPClientSettings: confighttp.HTTPClientSettings{
-					Endpoint: "localhost:8089",
+					Endpoint: "ftp://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Endpoint without host",
+			expect: errBadOrMissingEndpoint,
+			conf: Config{
+				Username: "admin",
+				Password: "securityFirst",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://",
+				},
+			},
+		},
+		{
+			desc:   "Path prefix with query",
+			expect: errBadPathPrefix,
+			conf: Config{
+				Username:   "admin",
+				Password:   "securityFirst",
+				PathPrefix: "/splunk?admin=true",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Auth login path with host",
+			expect: errBadAuthLoginPath,
+			conf: Config{
+				Username:      "admin",
+				Password:      "securityFirst",
+				AuthLoginPath: "https://sso.example.com/services/auth/login",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
 				},
 			},
 		},
@@ -71,6 +124,377 @@ func TestValidateConfig(t *testing.T) {
 				Password: "securityFirst",
 			},
 		},
+		{
+			desc:   "Non positive max search poll interval",
+			expect: errBadPollInterval,
+			conf: Config{
+				Username: "admin",
+				Password: "securityFirst",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Non positive max concurrent searches",
+			expect: errBadConcurrency,
+			conf: Config{
+				Username: "admin",
+				Password: "securityFirst",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Search poll interval not below max search wait time",
+			expect: errBadSearchPoll,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchWaitTime:     10 * time.Second,
+				SearchPollInterval:    10 * time.Second,
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Negative session key ttl",
+			expect: errBadSessionKeyTTL,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				SessionKeyTTL:         -1 * time.Second,
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Negative request retries",
+			expect: errBadRetries,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				MaxRequestRetries:     -1,
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Empty saved search name",
+			expect: errEmptySavedSearch,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				SavedSearches:         []string{"Errors in the last hour", ""},
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Negative results read timeout",
+			expect: errBadResultsTimeout,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				ResultsReadTimeout:    -1 * time.Second,
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Empty source type name",
+			expect: errEmptySourcetype,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				Sourcetypes:           []string{""},
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Bad license usage index",
+			expect: errBadLicenseIndex,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				LicenseUsageIndexes:   []string{"main", `web" OR idx=*`},
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Bad ingestion latency statistic",
+			expect: errBadLatencyStatistic,
+			conf: Config{
+				Username:                  "admin",
+				Password:                  "securityFirst",
+				MaxSearchPollInterval:     time.Second,
+				MaxConcurrentSearches:     1,
+				IngestionLatencyStatistic: "perc95",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Empty user name",
+			expect: errEmptyUser,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				Users:                 []string{""},
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Empty app name",
+			expect: errEmptyApp,
+			conf: Config{
+				Username:              "admin",
+				Password:              "securityFirst",
+				MaxSearchPollInterval: time.Second,
+				MaxConcurrentSearches: 1,
+				Apps:                  []string{"search", ""},
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Endpoint and instances",
+			expect: errConflictingEndpoints,
+			conf: Config{
+				Username:  "admin",
+				Password:  "securityFirst",
+				Instances: []InstanceConfig{{Endpoint: "https://indexer1:8089"}},
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+			},
+		},
+		{
+			desc:   "Instance missing password",
+			expect: errMissingPassword,
+			conf: Config{
+				Token: "eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig",
+				Instances: []InstanceConfig{
+					{Endpoint: "https://indexer1:8089"},
+					{Endpoint: "https://indexer2:8089", Username: "admin"},
+				},
+			},
+		},
+		{
+			desc:   "Duplicate instance names",
+			expect: errDuplicateInstance,
+			conf: Config{
+				Username: "admin",
+				Password: "securityFirst",
+				Instances: []InstanceConfig{
+					{Endpoint: "https://indexer1:8089"},
+					{Name: "indexer1:8089", Endpoint: "https://indexer1.internal:8089"},
+				},
+			},
+		},
+		{
+			desc:   "Custom search for an API based metric",
+			expect: errUnknownCustomSearch,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username: "admin",
+				Password: "securityFirst",
+				CustomSearches: map[string]CustomSearch{
+					"splunk.indexer.throughput": {Search: "index=_internal group=thruput", Field: "kbps"},
+				},
+			},
+		},
+		{
+			desc:   "Endpoint override for a search based metric",
+			expect: errUnknownOverride,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username: "admin",
+				Password: "securityFirst",
+				EndpointOverrides: map[string]string{
+					"splunk.license.index.usage": "/services/licenser/usage?output_mode=json",
+				},
+			},
+		},
+		{
+			desc:   "Endpoint override with a host",
+			expect: errBadOverride,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username: "admin",
+				Password: "securityFirst",
+				EndpointOverrides: map[string]string{
+					"splunk.license.pool.used.bytes": "https://license.example.com/services/licenser/pools?output_mode=json",
+				},
+			},
+		},
+		{
+			desc:   "Conflicting endpoint overrides",
+			expect: errConflictingOverride,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username: "admin",
+				Password: "securityFirst",
+				EndpointOverrides: map[string]string{
+					"splunk.license.pool.used.bytes":  "/services/licenser/pools?output_mode=json",
+					"splunk.license.pool.quota.bytes": "/services/licenser/pools?output_mode=json&count=0",
+				},
+			},
+		},
+		{
+			desc:   "Empty custom search",
+			expect: errEmptyCustomSearch,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username: "admin",
+				Password: "securityFirst",
+				CustomSearches: map[string]CustomSearch{
+					"splunk.license.index.usage": {Field: "bytes"},
+				},
+			},
+		},
+		{
+			desc:   "Custom search with a saved search",
+			expect: errConflictingSearch,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username: "admin",
+				Password: "securityFirst",
+				CustomSearches: map[string]CustomSearch{
+					"splunk.license.index.usage": {
+						Search:          "search index=_internal",
+						SavedSearchName: "license usage",
+						Field:           "bytes",
+					},
+				},
+			},
+		},
+		{
+			desc:   "Bad proxy url",
+			expect: errBadProxyURL,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username: "admin",
+				Password: "securityFirst",
+				ProxyURL: "proxy.internal:3128",
+			},
+		},
+		{
+			desc:   "Blank search earliest time",
+			expect: errBlankSearchTime,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username:           "admin",
+				Password:           "securityFirst",
+				SearchEarliestTime: " ",
+			},
+		},
+		{
+			desc:   "Bad bucket events source",
+			expect: errBadBucketEvents,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username:           "admin",
+				Password:           "securityFirst",
+				BucketEventsSource: "introspection",
+			},
+		},
+		{
+			desc:   "Bad adhoc search level",
+			expect: errBadAdhocSearchLevel,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username:         "admin",
+				Password:         "securityFirst",
+				AdhocSearchLevel: "turbo",
+			},
+		},
+		{
+			desc:   "Bad search output mode",
+			expect: errBadSearchOutputMode,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username:         "admin",
+				Password:         "securityFirst",
+				SearchOutputMode: "csv",
+			},
+		},
+		{
+			desc:   "Bad index regex",
+			expect: errBadIndexRegex,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username:          "admin",
+				Password:          "securityFirst",
+				IndexExcludeRegex: "^_(internal",
+			},
+		},
+		{
+			desc:   "Empty license index field",
+			expect: errEmptyLicenseField,
+			conf: Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://localhost:8089",
+				},
+				Username:          "admin",
+				Password:          "securityFirst",
+				LicenseBytesField: "By",
+			},
+		},
 		{
 			desc:   "Missing multiple",
 			expect: multipleErrors,
@@ -104,12 +528,42 @@ func TestLoadConfig(t *testing.T) {
 	testmetrics.Metrics.SplunkLicenseIndexUsage.Enabled = true
 	testmetrics.Metrics.SplunkIndexerThroughput.Enabled = false
 
+	maxIdleConns := 100
+	maxIdleConnsPerHost := 20
+	idleConnTimeout := 90 * time.Second
+
 	expected := &Config{
-		Username:          "admin",
-		Password:          "securityFirst",
-		MaxSearchWaitTime: 11 * time.Second,
+		Username:                "admin",
+		Password:                "securityFirst",
+		MaxSearchWaitTime:       11 * time.Second,
+		SearchPollInterval:      500 * time.Millisecond,
+		MaxSearchPollInterval:   2 * time.Second,
+		ResultsReadTimeout:      20 * time.Second,
+		MaxConcurrentSearches:   2,
+		SessionKeyTTL:           15 * time.Minute,
+		AuthLoginPath:           "/sso/services/auth/login",
+		AuthFailureThreshold:    5,
+		AuthFailureCooldown:     time.Minute,
+		MaxRequestRetries:       3,
+		RequestRetryBackoff:     500 * time.Millisecond,
+		SearchOwner:             "nobody",
+		SearchApp:               "license_app",
+		AdhocSearchLevel:        "smart",
+		LicenseIndexField:       "indexname",
+		LicenseBytesField:       "By",
+		SearchEarliestTime:      "-1d@d+6h",
+		SearchLatestTime:        "@d+6h",
+		VerifyConnectionOnStart: false,
+		BucketEventsSource:      bucketEventsSourceAPI,
+		SearchOutputMode:        searchOutputModeXML,
+		SavedSearches:           []string{"Errors in the last hour"},
+		Sourcetypes:             []string{"access_combined"},
+		IndexExcludeRegex:       "^_",
 		HTTPClientSettings: confighttp.HTTPClientSettings{
-			Endpoint: "https://localhost:8089",
+			Endpoint:            "https://localhost:8089",
+			MaxIdleConns:        &maxIdleConns,
+			MaxIdleConnsPerHost: &maxIdleConnsPerHost,
+			IdleConnTimeout:     &idleConnTimeout,
 		},
 		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
 			CollectionInterval: 10 * time.Second,
@@ -129,3 +583,30 @@ func TestLoadConfig(t *testing.T) {
 		t.Errorf("config mismatch (-expected / +actual)\n%s", diff)
 	}
 }
+
+// credentials are opaque, neither marshaling nor logging the config gives them away
+func TestConfigCredentialsRedacted(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "https://localhost:8089"
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.Instances = []InstanceConfig{{
+		Name:     "indexer1",
+		Endpoint: "https://indexer1:8089",
+		Token:    "eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig",
+	}}
+
+	conf := confmap.New()
+	require.NoError(t, conf.Marshal(cfg))
+	require.Equal(t, "[REDACTED]", conf.Get("password"))
+
+	var logged bytes.Buffer
+	logger := zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&logged), zap.InfoLevel))
+	logger.Info("config", zap.Any("password", cfg.Password), zap.Any("instances", cfg.Instances))
+	require.Contains(t, logged.String(), "[REDACTED]")
+
+	for _, out := range []string{fmt.Sprint(conf.ToStringMap()), logged.String()} {
+		require.NotContains(t, out, "securityFirst")
+		require.NotContains(t, out, "eyJraWQiOiJzcGx1bmsuc2VjcmV0Ig")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -18,24 +19,80 @@ import (
 const (
 	defaultInterval          = 10 * time.Minute
 	defaultMaxSearchWaitTime = 60 * time.Second
+	defaultPollInterval      = 200 * time.Millisecond
+	defaultMaxPollInterval   = 5 * time.Second
+	defaultResultsTimeout    = 30 * time.Second
+	defaultMaxConcurrency    = 4
+	defaultSessionKeyTTL     = 30 * time.Minute
+	defaultAuthFailures      = 3
+	defaultAuthCooldown      = 5 * time.Minute
+	defaultMaxRequestRetries = 2
+	defaultRetryBackoff      = time.Second
+	defaultAuthLoginPath     = "/services/auth/login"
+	defaultSearchOwner       = "nobody"
+	defaultSearchApp         = "search"
+	defaultAdhocSearchLevel  = "fast"
+	defaultLicenseIndexField = "indexname"
+	defaultLicenseBytesField = "By"
+	// a scrape runs up to max_concurrent_searches requests against the instance at once, more than
+	// the 2 idle connections per host Go keeps by default, which has the others dial anew
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 10
+	defaultIdleConnTimeout     = 90 * time.Second
 )
 
 func createDefaultConfig() component.Config {
 	scfg := scraperhelper.NewDefaultScraperControllerSettings(metadata.Type)
 	scfg.CollectionInterval = defaultInterval
 
+	maxIdleConns := defaultMaxIdleConns
+	maxIdleConnsPerHost := defaultMaxIdleConnsPerHost
+	idleConnTimeout := defaultIdleConnTimeout
+
 	return &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			MaxIdleConns:        &maxIdleConns,
+			MaxIdleConnsPerHost: &maxIdleConnsPerHost,
+			IdleConnTimeout:     &idleConnTimeout,
+		},
 		ScraperControllerSettings: scfg,
 		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
 		MaxSearchWaitTime:         defaultMaxSearchWaitTime,
+		SearchPollInterval:        defaultPollInterval,
+		MaxSearchPollInterval:     defaultMaxPollInterval,
+		ResultsReadTimeout:        defaultResultsTimeout,
+		MaxConcurrentSearches:     defaultMaxConcurrency,
+		SessionKeyTTL:             defaultSessionKeyTTL,
+		AuthFailureThreshold:      defaultAuthFailures,
+		AuthFailureCooldown:       defaultAuthCooldown,
+		AuthLoginPath:             defaultAuthLoginPath,
+		MaxRequestRetries:         defaultMaxRequestRetries,
+		RequestRetryBackoff:       defaultRetryBackoff,
+		SearchOwner:               defaultSearchOwner,
+		SearchApp:                 defaultSearchApp,
+		AdhocSearchLevel:          defaultAdhocSearchLevel,
+		LicenseIndexField:         defaultLicenseIndexField,
+		LicenseBytesField:         defaultLicenseBytesField,
+		VerifyConnectionOnStart:   true,
+		BucketEventsSource:        bucketEventsSourceSearch,
+		SearchOutputMode:          searchOutputModeJSON,
 	}
 }
 
 func NewFactory() receiver.Factory {
+	return newFactory()
+}
+
+// Factory whose receivers build the clients of their instances with the given options, e.g. to
+// send requests through a client of a test's own
+func newFactory(opts ...clientOption) receiver.Factory {
+	create := func(ctx context.Context, params receiver.CreateSettings, cfg component.Config, consumer consumer.Metrics) (receiver.Metrics, error) {
+		return createMetricsReceiver(ctx, params, cfg, consumer, opts...)
+	}
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithMetrics(create, metadata.MetricsStability),
 	)
 }
 
@@ -44,9 +101,10 @@ func createMetricsReceiver(
 	params receiver.CreateSettings,
 	baseCfg component.Config,
 	consumer consumer.Metrics,
+	opts ...clientOption,
 ) (receiver.Metrics, error) {
 	cfg := baseCfg.(*Config)
-	splunkScraper := newSplunkMetricsScraper(params, cfg)
+	splunkScraper := newSplunkMetricsScraper(params, cfg, opts...)
 
 	scraper, err := scraperhelper.NewScraper(metadata.Type,
 		splunkScraper.scrape,
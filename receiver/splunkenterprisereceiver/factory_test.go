@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -22,8 +23,35 @@ func TestFactoryCreate(t *testing.T) {
 }
 
 func TestDefaultConfig(t *testing.T) {
+	maxIdleConns := 100
+	maxIdleConnsPerHost := 10
+	idleConnTimeout := 90 * time.Second
+
 	expectedConf := &Config{
-		MaxSearchWaitTime: 60 * time.Second,
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			MaxIdleConns:        &maxIdleConns,
+			MaxIdleConnsPerHost: &maxIdleConnsPerHost,
+			IdleConnTimeout:     &idleConnTimeout,
+		},
+		MaxSearchWaitTime:       60 * time.Second,
+		SearchPollInterval:      200 * time.Millisecond,
+		MaxSearchPollInterval:   5 * time.Second,
+		ResultsReadTimeout:      30 * time.Second,
+		MaxConcurrentSearches:   4,
+		SessionKeyTTL:           30 * time.Minute,
+		AuthFailureThreshold:    3,
+		AuthFailureCooldown:     5 * time.Minute,
+		AuthLoginPath:           "/services/auth/login",
+		MaxRequestRetries:       2,
+		RequestRetryBackoff:     time.Second,
+		SearchOwner:             "nobody",
+		SearchApp:               "search",
+		AdhocSearchLevel:        "fast",
+		LicenseIndexField:       "indexname",
+		LicenseBytesField:       "By",
+		VerifyConnectionOnStart: true,
+		BucketEventsSource:      bucketEventsSourceSearch,
+		SearchOutputMode:        searchOutputModeJSON,
 		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
 			CollectionInterval: 10 * time.Minute,
 			InitialDelay:       1 * time.Second,
@@ -25,28 +25,436 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for splunkenterprise metrics.
 type MetricsConfig struct {
-	SplunkIndexerThroughput MetricConfig `mapstructure:"splunk.indexer.throughput"`
-	SplunkLicenseIndexUsage MetricConfig `mapstructure:"splunk.license.index.usage"`
+	SplunkAlertsTriggeredCount              MetricConfig `mapstructure:"splunk.alerts.triggered.count"`
+	SplunkAlertsTriggeredOldestSeconds      MetricConfig `mapstructure:"splunk.alerts.triggered.oldest.seconds"`
+	SplunkAuthFailuresCount                 MetricConfig `mapstructure:"splunk.auth.failures.count"`
+	SplunkClusterFixupPendingCount          MetricConfig `mapstructure:"splunk.cluster.fixup.pending.count"`
+	SplunkClusterIndexReplicatedCopies      MetricConfig `mapstructure:"splunk.cluster.index.replicated.copies"`
+	SplunkClusterIndexSearchable            MetricConfig `mapstructure:"splunk.cluster.index.searchable"`
+	SplunkClusterPeerFixupTasks             MetricConfig `mapstructure:"splunk.cluster.peer.fixup.tasks"`
+	SplunkClusterPeerReplicationQueueLength MetricConfig `mapstructure:"splunk.cluster.peer.replication.queue.length"`
+	SplunkClusterPeerStatus                 MetricConfig `mapstructure:"splunk.cluster.peer.status"`
+	SplunkClusterReplicationFactor          MetricConfig `mapstructure:"splunk.cluster.replication.factor"`
+	SplunkClusterReplicationFactorMet       MetricConfig `mapstructure:"splunk.cluster.replication.factor.met"`
+	SplunkClusterSearchFactor               MetricConfig `mapstructure:"splunk.cluster.search.factor"`
+	SplunkClusterSearchFactorMet            MetricConfig `mapstructure:"splunk.cluster.search.factor.met"`
+	SplunkDatamodelAccelerationPercent      MetricConfig `mapstructure:"splunk.datamodel.acceleration.percent"`
+	SplunkDatamodelAccelerationSizeBytes    MetricConfig `mapstructure:"splunk.datamodel.acceleration.size.bytes"`
+	SplunkDeploymentClientsCount            MetricConfig `mapstructure:"splunk.deployment.clients.count"`
+	SplunkDeploymentServerclassClients      MetricConfig `mapstructure:"splunk.deployment.serverclass.clients"`
+	SplunkDispatchArtifactCount             MetricConfig `mapstructure:"splunk.dispatch.artifact.count"`
+	SplunkDispatchDiskUsedBytes             MetricConfig `mapstructure:"splunk.dispatch.disk.used.bytes"`
+	SplunkForwarderConnectionsCount         MetricConfig `mapstructure:"splunk.forwarder.connections.count"`
+	SplunkForwarderDataReceivedBytes        MetricConfig `mapstructure:"splunk.forwarder.data.received.bytes"`
+	SplunkHecDataReceivedBytes              MetricConfig `mapstructure:"splunk.hec.data.received.bytes"`
+	SplunkHecErrorsCount                    MetricConfig `mapstructure:"splunk.hec.errors.count"`
+	SplunkHecRequestsCount                  MetricConfig `mapstructure:"splunk.hec.requests.count"`
+	SplunkHecTokenEnabled                   MetricConfig `mapstructure:"splunk.hec.token.enabled"`
+	SplunkIndexBucketCount                  MetricConfig `mapstructure:"splunk.index.bucket.count"`
+	SplunkIndexBucketsFrozenCount           MetricConfig `mapstructure:"splunk.index.buckets.frozen.count"`
+	SplunkIndexBucketsRolledCount           MetricConfig `mapstructure:"splunk.index.buckets.rolled.count"`
+	SplunkIndexEarliestEventSeconds         MetricConfig `mapstructure:"splunk.index.earliest.event.seconds"`
+	SplunkIndexEventCount                   MetricConfig `mapstructure:"splunk.index.event.count"`
+	SplunkIndexEventsSearchedRate           MetricConfig `mapstructure:"splunk.index.events.searched.rate"`
+	SplunkIndexEventsWrittenRate            MetricConfig `mapstructure:"splunk.index.events.written.rate"`
+	SplunkIndexFrozenTimeSeconds            MetricConfig `mapstructure:"splunk.index.frozen.time.seconds"`
+	SplunkIndexHotBucketsCount              MetricConfig `mapstructure:"splunk.index.hot.buckets.count"`
+	SplunkIndexHotBucketsMax                MetricConfig `mapstructure:"splunk.index.hot.buckets.max"`
+	SplunkIndexLatestEventSeconds           MetricConfig `mapstructure:"splunk.index.latest.event.seconds"`
+	SplunkIndexMaxSizeBytes                 MetricConfig `mapstructure:"splunk.index.max.size.bytes"`
+	SplunkIndexRawSizeBytes                 MetricConfig `mapstructure:"splunk.index.raw.size.bytes"`
+	SplunkIndexSearchDurationSeconds        MetricConfig `mapstructure:"splunk.index.search.duration.seconds"`
+	SplunkIndexThawedSizeBytes              MetricConfig `mapstructure:"splunk.index.thawed.size.bytes"`
+	SplunkIndexerIngestionLatencySeconds    MetricConfig `mapstructure:"splunk.indexer.ingestion.latency.seconds"`
+	SplunkIndexerQueueLatencySeconds        MetricConfig `mapstructure:"splunk.indexer.queue.latency.seconds"`
+	SplunkIndexerQueueRatio                 MetricConfig `mapstructure:"splunk.indexer.queue.ratio"`
+	SplunkIndexerThroughput                 MetricConfig `mapstructure:"splunk.indexer.throughput"`
+	SplunkIndexerThroughputBySourcetype     MetricConfig `mapstructure:"splunk.indexer.throughput.by_sourcetype"`
+	SplunkIndexesCount                      MetricConfig `mapstructure:"splunk.indexes.count"`
+	SplunkKvstoreBackupRestoreStatus        MetricConfig `mapstructure:"splunk.kvstore.backup.restore.status"`
+	SplunkKvstoreCollectionCount            MetricConfig `mapstructure:"splunk.kvstore.collection.count"`
+	SplunkKvstoreCollectionSizeBytes        MetricConfig `mapstructure:"splunk.kvstore.collection.size.bytes"`
+	SplunkKvstoreReplicationStatus          MetricConfig `mapstructure:"splunk.kvstore.replication.status"`
+	SplunkKvstoreStatus                     MetricConfig `mapstructure:"splunk.kvstore.status"`
+	SplunkLicenseIndexUsage                 MetricConfig `mapstructure:"splunk.license.index.usage"`
+	SplunkLicensePoolQuotaBytes             MetricConfig `mapstructure:"splunk.license.pool.quota.bytes"`
+	SplunkLicensePoolUsedBytes              MetricConfig `mapstructure:"splunk.license.pool.used.bytes"`
+	SplunkLicenseSlaveCount                 MetricConfig `mapstructure:"splunk.license.slave.count"`
+	SplunkLicenseViolation                  MetricConfig `mapstructure:"splunk.license.violation"`
+	SplunkLicenseWarningCount               MetricConfig `mapstructure:"splunk.license.warning.count"`
+	SplunkPipelineCPUSeconds                MetricConfig `mapstructure:"splunk.pipeline.cpu.seconds"`
+	SplunkProcessCPUPercent                 MetricConfig `mapstructure:"splunk.process.cpu.percent"`
+	SplunkProcessMemoryBytes                MetricConfig `mapstructure:"splunk.process.memory.bytes"`
+	SplunkReceiverSearchWaitSeconds         MetricConfig `mapstructure:"splunk.receiver.search.wait.seconds"`
+	SplunkSavedsearchAlertFiredCount        MetricConfig `mapstructure:"splunk.savedsearch.alert.fired.count"`
+	SplunkSavedsearchAlertSuppressedCount   MetricConfig `mapstructure:"splunk.savedsearch.alert.suppressed.count"`
+	SplunkSchedulerExecutionDuration        MetricConfig `mapstructure:"splunk.scheduler.execution.duration"`
+	SplunkSchedulerLagSeconds               MetricConfig `mapstructure:"splunk.scheduler.lag.seconds"`
+	SplunkSchedulerOldestQueuedSeconds      MetricConfig `mapstructure:"splunk.scheduler.oldest.queued.seconds"`
+	SplunkSchedulerSkippedCount             MetricConfig `mapstructure:"splunk.scheduler.skipped.count"`
+	SplunkSearchCacheHitRatio               MetricConfig `mapstructure:"splunk.search.cache.hit.ratio"`
+	SplunkSearchCount                       MetricConfig `mapstructure:"splunk.search.count"`
+	SplunkSearchEventCount                  MetricConfig `mapstructure:"splunk.search.event.count"`
+	SplunkSearchRunDurationSeconds          MetricConfig `mapstructure:"splunk.search.run.duration.seconds"`
+	SplunkSearchScanCount                   MetricConfig `mapstructure:"splunk.search.scan.count"`
+	SplunkSearchTimeoutCount                MetricConfig `mapstructure:"splunk.search.timeout.count"`
+	SplunkSearchesLimit                     MetricConfig `mapstructure:"splunk.searches.limit"`
+	SplunkSearchesQueuedCount               MetricConfig `mapstructure:"splunk.searches.queued.count"`
+	SplunkSearchesRealtimeLimit             MetricConfig `mapstructure:"splunk.searches.realtime.limit"`
+	SplunkSearchesRealtimeRunningCount      MetricConfig `mapstructure:"splunk.searches.realtime.running.count"`
+	SplunkSearchesRunningCount              MetricConfig `mapstructure:"splunk.searches.running.count"`
+	SplunkServerCPUUsagePercent             MetricConfig `mapstructure:"splunk.server.cpu.usage.percent"`
+	SplunkServerMemoryUsageBytes            MetricConfig `mapstructure:"splunk.server.memory.usage.bytes"`
+	SplunkServerPartitionCapacityBytes      MetricConfig `mapstructure:"splunk.server.partition.capacity.bytes"`
+	SplunkServerPartitionFreeBytes          MetricConfig `mapstructure:"splunk.server.partition.free.bytes"`
+	SplunkServerPartitionUsedBytes          MetricConfig `mapstructure:"splunk.server.partition.used.bytes"`
+	SplunkShcArtifactReplicationFailures    MetricConfig `mapstructure:"splunk.shc.artifact.replication.failures"`
+	SplunkShcCaptainElectionCount           MetricConfig `mapstructure:"splunk.shc.captain.election.count"`
+	SplunkShcMemberStatus                   MetricConfig `mapstructure:"splunk.shc.member.status"`
+	SplunkShcReplicationPendingCount        MetricConfig `mapstructure:"splunk.shc.replication.pending.count"`
+	SplunkShcReplicationStatus              MetricConfig `mapstructure:"splunk.shc.replication.status"`
+	SplunkSmartstoreCacheHitRatio           MetricConfig `mapstructure:"splunk.smartstore.cache.hit.ratio"`
+	SplunkSmartstoreEvictionCount           MetricConfig `mapstructure:"splunk.smartstore.eviction.count"`
+	SplunkSmartstoreUploadPendingCount      MetricConfig `mapstructure:"splunk.smartstore.upload.pending.count"`
+	SplunkSourcetypeEventCount              MetricConfig `mapstructure:"splunk.sourcetype.event.count"`
+	SplunkUp                                MetricConfig `mapstructure:"splunk.up"`
+	SplunkUserDispatchQuotaLimit            MetricConfig `mapstructure:"splunk.user.dispatch.quota.limit"`
+	SplunkUserDispatchQuotaUsed             MetricConfig `mapstructure:"splunk.user.dispatch.quota.used"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		SplunkAlertsTriggeredCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkAlertsTriggeredOldestSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkAuthFailuresCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterFixupPendingCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterIndexReplicatedCopies: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterIndexSearchable: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterPeerFixupTasks: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterPeerReplicationQueueLength: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterPeerStatus: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterReplicationFactor: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterReplicationFactorMet: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterSearchFactor: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterSearchFactorMet: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDatamodelAccelerationPercent: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDatamodelAccelerationSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDeploymentClientsCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDeploymentServerclassClients: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDispatchArtifactCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDispatchDiskUsedBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkForwarderConnectionsCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkForwarderDataReceivedBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkHecDataReceivedBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkHecErrorsCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkHecRequestsCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkHecTokenEnabled: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketsFrozenCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketsRolledCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexEarliestEventSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexEventCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexEventsSearchedRate: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexEventsWrittenRate: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexFrozenTimeSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexHotBucketsCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexHotBucketsMax: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexLatestEventSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexMaxSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexRawSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexSearchDurationSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexThawedSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexerIngestionLatencySeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexerQueueLatencySeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexerQueueRatio: MetricConfig{
+			Enabled: false,
+		},
 		SplunkIndexerThroughput: MetricConfig{
 			Enabled: true,
 		},
+		SplunkIndexerThroughputBySourcetype: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexesCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreBackupRestoreStatus: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreCollectionCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreCollectionSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreReplicationStatus: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreStatus: MetricConfig{
+			Enabled: false,
+		},
 		SplunkLicenseIndexUsage: MetricConfig{
 			Enabled: true,
 		},
+		SplunkLicensePoolQuotaBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkLicensePoolUsedBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkLicenseSlaveCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkLicenseViolation: MetricConfig{
+			Enabled: false,
+		},
+		SplunkLicenseWarningCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkPipelineCPUSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkProcessCPUPercent: MetricConfig{
+			Enabled: false,
+		},
+		SplunkProcessMemoryBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverSearchWaitSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSavedsearchAlertFiredCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSavedsearchAlertSuppressedCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSchedulerExecutionDuration: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSchedulerLagSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSchedulerOldestQueuedSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSchedulerSkippedCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchCacheHitRatio: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchEventCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchRunDurationSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchScanCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchTimeoutCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchesLimit: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchesQueuedCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchesRealtimeLimit: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchesRealtimeRunningCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchesRunningCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkServerCPUUsagePercent: MetricConfig{
+			Enabled: false,
+		},
+		SplunkServerMemoryUsageBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkServerPartitionCapacityBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkServerPartitionFreeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkServerPartitionUsedBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkShcArtifactReplicationFailures: MetricConfig{
+			Enabled: false,
+		},
+		SplunkShcCaptainElectionCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkShcMemberStatus: MetricConfig{
+			Enabled: false,
+		},
+		SplunkShcReplicationPendingCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkShcReplicationStatus: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSmartstoreCacheHitRatio: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSmartstoreEvictionCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSmartstoreUploadPendingCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSourcetypeEventCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkUp: MetricConfig{
+			Enabled: true,
+		},
+		SplunkUserDispatchQuotaLimit: MetricConfig{
+			Enabled: false,
+		},
+		SplunkUserDispatchQuotaUsed: MetricConfig{
+			Enabled: false,
+		},
+	}
+}
+
+// ResourceAttributeConfig provides common config for a particular resource attribute.
+type ResourceAttributeConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// ResourceAttributesConfig provides config for splunkenterprise resource attributes.
+type ResourceAttributesConfig struct {
+	SplunkInstance      ResourceAttributeConfig `mapstructure:"splunk.instance"`
+	SplunkServerGUID    ResourceAttributeConfig `mapstructure:"splunk.server.guid"`
+	SplunkServerName    ResourceAttributeConfig `mapstructure:"splunk.server.name"`
+	SplunkShcMemberGUID ResourceAttributeConfig `mapstructure:"splunk.shc.member.guid"`
+	SplunkVersion       ResourceAttributeConfig `mapstructure:"splunk.version"`
+}
+
+func DefaultResourceAttributesConfig() ResourceAttributesConfig {
+	return ResourceAttributesConfig{
+		SplunkInstance: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		SplunkServerGUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		SplunkServerName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		SplunkShcMemberGUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		SplunkVersion: ResourceAttributeConfig{
+			Enabled: true,
+		},
 	}
 }
 
 // MetricsBuilderConfig is a configuration for splunkenterprise metrics builder.
 type MetricsBuilderConfig struct {
-	Metrics MetricsConfig `mapstructure:"metrics"`
+	Metrics            MetricsConfig            `mapstructure:"metrics"`
+	ResourceAttributes ResourceAttributesConfig `mapstructure:"resource_attributes"`
 }
 
 func DefaultMetricsBuilderConfig() MetricsBuilderConfig {
 	return MetricsBuilderConfig{
-		Metrics: DefaultMetricsConfig(),
+		Metrics:            DefaultMetricsConfig(),
+		ResourceAttributes: DefaultResourceAttributesConfig(),
 	}
 }
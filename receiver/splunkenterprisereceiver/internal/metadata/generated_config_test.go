@@ -26,8 +26,108 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SplunkIndexerThroughput: MetricConfig{Enabled: true},
-					SplunkLicenseIndexUsage: MetricConfig{Enabled: true},
+					SplunkAlertsTriggeredCount:              MetricConfig{Enabled: true},
+					SplunkAlertsTriggeredOldestSeconds:      MetricConfig{Enabled: true},
+					SplunkAuthFailuresCount:                 MetricConfig{Enabled: true},
+					SplunkClusterFixupPendingCount:          MetricConfig{Enabled: true},
+					SplunkClusterIndexReplicatedCopies:      MetricConfig{Enabled: true},
+					SplunkClusterIndexSearchable:            MetricConfig{Enabled: true},
+					SplunkClusterPeerFixupTasks:             MetricConfig{Enabled: true},
+					SplunkClusterPeerReplicationQueueLength: MetricConfig{Enabled: true},
+					SplunkClusterPeerStatus:                 MetricConfig{Enabled: true},
+					SplunkClusterReplicationFactor:          MetricConfig{Enabled: true},
+					SplunkClusterReplicationFactorMet:       MetricConfig{Enabled: true},
+					SplunkClusterSearchFactor:               MetricConfig{Enabled: true},
+					SplunkClusterSearchFactorMet:            MetricConfig{Enabled: true},
+					SplunkDatamodelAccelerationPercent:      MetricConfig{Enabled: true},
+					SplunkDatamodelAccelerationSizeBytes:    MetricConfig{Enabled: true},
+					SplunkDeploymentClientsCount:            MetricConfig{Enabled: true},
+					SplunkDeploymentServerclassClients:      MetricConfig{Enabled: true},
+					SplunkDispatchArtifactCount:             MetricConfig{Enabled: true},
+					SplunkDispatchDiskUsedBytes:             MetricConfig{Enabled: true},
+					SplunkForwarderConnectionsCount:         MetricConfig{Enabled: true},
+					SplunkForwarderDataReceivedBytes:        MetricConfig{Enabled: true},
+					SplunkHecDataReceivedBytes:              MetricConfig{Enabled: true},
+					SplunkHecErrorsCount:                    MetricConfig{Enabled: true},
+					SplunkHecRequestsCount:                  MetricConfig{Enabled: true},
+					SplunkHecTokenEnabled:                   MetricConfig{Enabled: true},
+					SplunkIndexBucketCount:                  MetricConfig{Enabled: true},
+					SplunkIndexBucketsFrozenCount:           MetricConfig{Enabled: true},
+					SplunkIndexBucketsRolledCount:           MetricConfig{Enabled: true},
+					SplunkIndexEarliestEventSeconds:         MetricConfig{Enabled: true},
+					SplunkIndexEventCount:                   MetricConfig{Enabled: true},
+					SplunkIndexEventsSearchedRate:           MetricConfig{Enabled: true},
+					SplunkIndexEventsWrittenRate:            MetricConfig{Enabled: true},
+					SplunkIndexFrozenTimeSeconds:            MetricConfig{Enabled: true},
+					SplunkIndexHotBucketsCount:              MetricConfig{Enabled: true},
+					SplunkIndexHotBucketsMax:                MetricConfig{Enabled: true},
+					SplunkIndexLatestEventSeconds:           MetricConfig{Enabled: true},
+					SplunkIndexMaxSizeBytes:                 MetricConfig{Enabled: true},
+					SplunkIndexRawSizeBytes:                 MetricConfig{Enabled: true},
+					SplunkIndexSearchDurationSeconds:        MetricConfig{Enabled: true},
+					SplunkIndexThawedSizeBytes:              MetricConfig{Enabled: true},
+					SplunkIndexerIngestionLatencySeconds:    MetricConfig{Enabled: true},
+					SplunkIndexerQueueLatencySeconds:        MetricConfig{Enabled: true},
+					SplunkIndexerQueueRatio:                 MetricConfig{Enabled: true},
+					SplunkIndexerThroughput:                 MetricConfig{Enabled: true},
+					SplunkIndexerThroughputBySourcetype:     MetricConfig{Enabled: true},
+					SplunkIndexesCount:                      MetricConfig{Enabled: true},
+					SplunkKvstoreBackupRestoreStatus:        MetricConfig{Enabled: true},
+					SplunkKvstoreCollectionCount:            MetricConfig{Enabled: true},
+					SplunkKvstoreCollectionSizeBytes:        MetricConfig{Enabled: true},
+					SplunkKvstoreReplicationStatus:          MetricConfig{Enabled: true},
+					SplunkKvstoreStatus:                     MetricConfig{Enabled: true},
+					SplunkLicenseIndexUsage:                 MetricConfig{Enabled: true},
+					SplunkLicensePoolQuotaBytes:             MetricConfig{Enabled: true},
+					SplunkLicensePoolUsedBytes:              MetricConfig{Enabled: true},
+					SplunkLicenseSlaveCount:                 MetricConfig{Enabled: true},
+					SplunkLicenseViolation:                  MetricConfig{Enabled: true},
+					SplunkLicenseWarningCount:               MetricConfig{Enabled: true},
+					SplunkPipelineCPUSeconds:                MetricConfig{Enabled: true},
+					SplunkProcessCPUPercent:                 MetricConfig{Enabled: true},
+					SplunkProcessMemoryBytes:                MetricConfig{Enabled: true},
+					SplunkReceiverSearchWaitSeconds:         MetricConfig{Enabled: true},
+					SplunkSavedsearchAlertFiredCount:        MetricConfig{Enabled: true},
+					SplunkSavedsearchAlertSuppressedCount:   MetricConfig{Enabled: true},
+					SplunkSchedulerExecutionDuration:        MetricConfig{Enabled: true},
+					SplunkSchedulerLagSeconds:               MetricConfig{Enabled: true},
+					SplunkSchedulerOldestQueuedSeconds:      MetricConfig{Enabled: true},
+					SplunkSchedulerSkippedCount:             MetricConfig{Enabled: true},
+					SplunkSearchCacheHitRatio:               MetricConfig{Enabled: true},
+					SplunkSearchCount:                       MetricConfig{Enabled: true},
+					SplunkSearchEventCount:                  MetricConfig{Enabled: true},
+					SplunkSearchRunDurationSeconds:          MetricConfig{Enabled: true},
+					SplunkSearchScanCount:                   MetricConfig{Enabled: true},
+					SplunkSearchTimeoutCount:                MetricConfig{Enabled: true},
+					SplunkSearchesLimit:                     MetricConfig{Enabled: true},
+					SplunkSearchesQueuedCount:               MetricConfig{Enabled: true},
+					SplunkSearchesRealtimeLimit:             MetricConfig{Enabled: true},
+					SplunkSearchesRealtimeRunningCount:      MetricConfig{Enabled: true},
+					SplunkSearchesRunningCount:              MetricConfig{Enabled: true},
+					SplunkServerCPUUsagePercent:             MetricConfig{Enabled: true},
+					SplunkServerMemoryUsageBytes:            MetricConfig{Enabled: true},
+					SplunkServerPartitionCapacityBytes:      MetricConfig{Enabled: true},
+					SplunkServerPartitionFreeBytes:          MetricConfig{Enabled: true},
+					SplunkServerPartitionUsedBytes:          MetricConfig{Enabled: true},
+					SplunkShcArtifactReplicationFailures:    MetricConfig{Enabled: true},
+					SplunkShcCaptainElectionCount:           MetricConfig{Enabled: true},
+					SplunkShcMemberStatus:                   MetricConfig{Enabled: true},
+					SplunkShcReplicationPendingCount:        MetricConfig{Enabled: true},
+					SplunkShcReplicationStatus:              MetricConfig{Enabled: true},
+					SplunkSmartstoreCacheHitRatio:           MetricConfig{Enabled: true},
+					SplunkSmartstoreEvictionCount:           MetricConfig{Enabled: true},
+					SplunkSmartstoreUploadPendingCount:      MetricConfig{Enabled: true},
+					SplunkSourcetypeEventCount:              MetricConfig{Enabled: true},
+					SplunkUp:                                MetricConfig{Enabled: true},
+					SplunkUserDispatchQuotaLimit:            MetricConfig{Enabled: true},
+					SplunkUserDispatchQuotaUsed:             MetricConfig{Enabled: true},
+				},
+				ResourceAttributes: ResourceAttributesConfig{
+					SplunkInstance:      ResourceAttributeConfig{Enabled: true},
+					SplunkServerGUID:    ResourceAttributeConfig{Enabled: true},
+					SplunkServerName:    ResourceAttributeConfig{Enabled: true},
+					SplunkShcMemberGUID: ResourceAttributeConfig{Enabled: true},
+					SplunkVersion:       ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -35,8 +135,108 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					SplunkIndexerThroughput: MetricConfig{Enabled: false},
-					SplunkLicenseIndexUsage: MetricConfig{Enabled: false},
+					SplunkAlertsTriggeredCount:              MetricConfig{Enabled: false},
+					SplunkAlertsTriggeredOldestSeconds:      MetricConfig{Enabled: false},
+					SplunkAuthFailuresCount:                 MetricConfig{Enabled: false},
+					SplunkClusterFixupPendingCount:          MetricConfig{Enabled: false},
+					SplunkClusterIndexReplicatedCopies:      MetricConfig{Enabled: false},
+					SplunkClusterIndexSearchable:            MetricConfig{Enabled: false},
+					SplunkClusterPeerFixupTasks:             MetricConfig{Enabled: false},
+					SplunkClusterPeerReplicationQueueLength: MetricConfig{Enabled: false},
+					SplunkClusterPeerStatus:                 MetricConfig{Enabled: false},
+					SplunkClusterReplicationFactor:          MetricConfig{Enabled: false},
+					SplunkClusterReplicationFactorMet:       MetricConfig{Enabled: false},
+					SplunkClusterSearchFactor:               MetricConfig{Enabled: false},
+					SplunkClusterSearchFactorMet:            MetricConfig{Enabled: false},
+					SplunkDatamodelAccelerationPercent:      MetricConfig{Enabled: false},
+					SplunkDatamodelAccelerationSizeBytes:    MetricConfig{Enabled: false},
+					SplunkDeploymentClientsCount:            MetricConfig{Enabled: false},
+					SplunkDeploymentServerclassClients:      MetricConfig{Enabled: false},
+					SplunkDispatchArtifactCount:             MetricConfig{Enabled: false},
+					SplunkDispatchDiskUsedBytes:             MetricConfig{Enabled: false},
+					SplunkForwarderConnectionsCount:         MetricConfig{Enabled: false},
+					SplunkForwarderDataReceivedBytes:        MetricConfig{Enabled: false},
+					SplunkHecDataReceivedBytes:              MetricConfig{Enabled: false},
+					SplunkHecErrorsCount:                    MetricConfig{Enabled: false},
+					SplunkHecRequestsCount:                  MetricConfig{Enabled: false},
+					SplunkHecTokenEnabled:                   MetricConfig{Enabled: false},
+					SplunkIndexBucketCount:                  MetricConfig{Enabled: false},
+					SplunkIndexBucketsFrozenCount:           MetricConfig{Enabled: false},
+					SplunkIndexBucketsRolledCount:           MetricConfig{Enabled: false},
+					SplunkIndexEarliestEventSeconds:         MetricConfig{Enabled: false},
+					SplunkIndexEventCount:                   MetricConfig{Enabled: false},
+					SplunkIndexEventsSearchedRate:           MetricConfig{Enabled: false},
+					SplunkIndexEventsWrittenRate:            MetricConfig{Enabled: false},
+					SplunkIndexFrozenTimeSeconds:            MetricConfig{Enabled: false},
+					SplunkIndexHotBucketsCount:              MetricConfig{Enabled: false},
+					SplunkIndexHotBucketsMax:                MetricConfig{Enabled: false},
+					SplunkIndexLatestEventSeconds:           MetricConfig{Enabled: false},
+					SplunkIndexMaxSizeBytes:                 MetricConfig{Enabled: false},
+					SplunkIndexRawSizeBytes:                 MetricConfig{Enabled: false},
+					SplunkIndexSearchDurationSeconds:        MetricConfig{Enabled: false},
+					SplunkIndexThawedSizeBytes:              MetricConfig{Enabled: false},
+					SplunkIndexerIngestionLatencySeconds:    MetricConfig{Enabled: false},
+					SplunkIndexerQueueLatencySeconds:        MetricConfig{Enabled: false},
+					SplunkIndexerQueueRatio:                 MetricConfig{Enabled: false},
+					SplunkIndexerThroughput:                 MetricConfig{Enabled: false},
+					SplunkIndexerThroughputBySourcetype:     MetricConfig{Enabled: false},
+					SplunkIndexesCount:                      MetricConfig{Enabled: false},
+					SplunkKvstoreBackupRestoreStatus:        MetricConfig{Enabled: false},
+					SplunkKvstoreCollectionCount:            MetricConfig{Enabled: false},
+					SplunkKvstoreCollectionSizeBytes:        MetricConfig{Enabled: false},
+					SplunkKvstoreReplicationStatus:          MetricConfig{Enabled: false},
+					SplunkKvstoreStatus:                     MetricConfig{Enabled: false},
+					SplunkLicenseIndexUsage:                 MetricConfig{Enabled: false},
+					SplunkLicensePoolQuotaBytes:             MetricConfig{Enabled: false},
+					SplunkLicensePoolUsedBytes:              MetricConfig{Enabled: false},
+					SplunkLicenseSlaveCount:                 MetricConfig{Enabled: false},
+					SplunkLicenseViolation:                  MetricConfig{Enabled: false},
+					SplunkLicenseWarningCount:               MetricConfig{Enabled: false},
+					SplunkPipelineCPUSeconds:                MetricConfig{Enabled: false},
+					SplunkProcessCPUPercent:                 MetricConfig{Enabled: false},
+					SplunkProcessMemoryBytes:                MetricConfig{Enabled: false},
+					SplunkReceiverSearchWaitSeconds:         MetricConfig{Enabled: false},
+					SplunkSavedsearchAlertFiredCount:        MetricConfig{Enabled: false},
+					SplunkSavedsearchAlertSuppressedCount:   MetricConfig{Enabled: false},
+					SplunkSchedulerExecutionDuration:        MetricConfig{Enabled: false},
+					SplunkSchedulerLagSeconds:               MetricConfig{Enabled: false},
+					SplunkSchedulerOldestQueuedSeconds:      MetricConfig{Enabled: false},
+					SplunkSchedulerSkippedCount:             MetricConfig{Enabled: false},
+					SplunkSearchCacheHitRatio:               MetricConfig{Enabled: false},
+					SplunkSearchCount:                       MetricConfig{Enabled: false},
+					SplunkSearchEventCount:                  MetricConfig{Enabled: false},
+					SplunkSearchRunDurationSeconds:          MetricConfig{Enabled: false},
+					SplunkSearchScanCount:                   MetricConfig{Enabled: false},
+					SplunkSearchTimeoutCount:                MetricConfig{Enabled: false},
+					SplunkSearchesLimit:                     MetricConfig{Enabled: false},
+					SplunkSearchesQueuedCount:               MetricConfig{Enabled: false},
+					SplunkSearchesRealtimeLimit:             MetricConfig{Enabled: false},
+					SplunkSearchesRealtimeRunningCount:      MetricConfig{Enabled: false},
+					SplunkSearchesRunningCount:              MetricConfig{Enabled: false},
+					SplunkServerCPUUsagePercent:             MetricConfig{Enabled: false},
+					SplunkServerMemoryUsageBytes:            MetricConfig{Enabled: false},
+					SplunkServerPartitionCapacityBytes:      MetricConfig{Enabled: false},
+					SplunkServerPartitionFreeBytes:          MetricConfig{Enabled: false},
+					SplunkServerPartitionUsedBytes:          MetricConfig{Enabled: false},
+					SplunkShcArtifactReplicationFailures:    MetricConfig{Enabled: false},
+					SplunkShcCaptainElectionCount:           MetricConfig{Enabled: false},
+					SplunkShcMemberStatus:                   MetricConfig{Enabled: false},
+					SplunkShcReplicationPendingCount:        MetricConfig{Enabled: false},
+					SplunkShcReplicationStatus:              MetricConfig{Enabled: false},
+					SplunkSmartstoreCacheHitRatio:           MetricConfig{Enabled: false},
+					SplunkSmartstoreEvictionCount:           MetricConfig{Enabled: false},
+					SplunkSmartstoreUploadPendingCount:      MetricConfig{Enabled: false},
+					SplunkSourcetypeEventCount:              MetricConfig{Enabled: false},
+					SplunkUp:                                MetricConfig{Enabled: false},
+					SplunkUserDispatchQuotaLimit:            MetricConfig{Enabled: false},
+					SplunkUserDispatchQuotaUsed:             MetricConfig{Enabled: false},
+				},
+				ResourceAttributes: ResourceAttributesConfig{
+					SplunkInstance:      ResourceAttributeConfig{Enabled: false},
+					SplunkServerGUID:    ResourceAttributeConfig{Enabled: false},
+					SplunkServerName:    ResourceAttributeConfig{Enabled: false},
+					SplunkShcMemberGUID: ResourceAttributeConfig{Enabled: false},
+					SplunkVersion:       ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -44,7 +244,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := loadMetricsBuilderConfig(t, tt.name)
-			if diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(MetricConfig{})); diff != "" {
+			if diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(MetricConfig{}, ResourceAttributeConfig{})); diff != "" {
 				t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
 			}
 		})
@@ -60,3 +260,55 @@ func loadMetricsBuilderConfig(t *testing.T, name string) MetricsBuilderConfig {
 	require.NoError(t, component.UnmarshalConfig(sub, &cfg))
 	return cfg
 }
+
+func TestResourceAttributesConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		want ResourceAttributesConfig
+	}{
+		{
+			name: "default",
+			want: DefaultResourceAttributesConfig(),
+		},
+		{
+			name: "all_set",
+			want: ResourceAttributesConfig{
+				SplunkInstance:      ResourceAttributeConfig{Enabled: true},
+				SplunkServerGUID:    ResourceAttributeConfig{Enabled: true},
+				SplunkServerName:    ResourceAttributeConfig{Enabled: true},
+				SplunkShcMemberGUID: ResourceAttributeConfig{Enabled: true},
+				SplunkVersion:       ResourceAttributeConfig{Enabled: true},
+			},
+		},
+		{
+			name: "none_set",
+			want: ResourceAttributesConfig{
+				SplunkInstance:      ResourceAttributeConfig{Enabled: false},
+				SplunkServerGUID:    ResourceAttributeConfig{Enabled: false},
+				SplunkServerName:    ResourceAttributeConfig{Enabled: false},
+				SplunkShcMemberGUID: ResourceAttributeConfig{Enabled: false},
+				SplunkVersion:       ResourceAttributeConfig{Enabled: false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadResourceAttributesConfig(t, tt.name)
+			if diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(ResourceAttributeConfig{})); diff != "" {
+				t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func loadResourceAttributesConfig(t *testing.T, name string) ResourceAttributesConfig {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	sub, err := cm.Sub(name)
+	require.NoError(t, err)
+	sub, err = sub.Sub("resource_attributes")
+	require.NoError(t, err)
+	cfg := DefaultResourceAttributesConfig()
+	require.NoError(t, component.UnmarshalConfig(sub, &cfg))
+	return cfg
+}
@@ -11,41 +11,4752 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+type metricSplunkAlertsTriggeredCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.alerts.triggered.count metric with initial data.
+func (m *metricSplunkAlertsTriggeredCount) init() {
+	m.data.SetName("splunk.alerts.triggered.count")
+	m.data.SetDescription("Gauge tracking the number of triggered alerts Splunk retains, a sudden rise points to an alert storm")
+	m.data.SetUnit("{alerts}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkAlertsTriggeredCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkAlertsTriggeredCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkAlertsTriggeredCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkAlertsTriggeredCount(cfg MetricConfig) metricSplunkAlertsTriggeredCount {
+	m := metricSplunkAlertsTriggeredCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkAlertsTriggeredOldestSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.alerts.triggered.oldest.seconds metric with initial data.
+func (m *metricSplunkAlertsTriggeredOldestSeconds) init() {
+	m.data.SetName("splunk.alerts.triggered.oldest.seconds")
+	m.data.SetDescription("Gauge tracking how long ago the oldest triggered alert Splunk retains was triggered, 0 when none is retained")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkAlertsTriggeredOldestSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkAlertsTriggeredOldestSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkAlertsTriggeredOldestSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkAlertsTriggeredOldestSeconds(cfg MetricConfig) metricSplunkAlertsTriggeredOldestSeconds {
+	m := metricSplunkAlertsTriggeredOldestSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkAuthFailuresCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.auth.failures.count metric with initial data.
+func (m *metricSplunkAuthFailuresCount) init() {
+	m.data.SetName("splunk.auth.failures.count")
+	m.data.SetDescription("Number of logins to '/services/auth/login' the instance rejected since the receiver started. Only reported when session keys are used")
+	m.data.SetUnit("{failures}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSplunkAuthFailuresCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkAuthFailuresCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkAuthFailuresCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkAuthFailuresCount(cfg MetricConfig) metricSplunkAuthFailuresCount {
+	m := metricSplunkAuthFailuresCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterFixupPendingCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.fixup.pending.count metric with initial data.
+func (m *metricSplunkClusterFixupPendingCount) init() {
+	m.data.SetName("splunk.cluster.fixup.pending.count")
+	m.data.SetDescription("Gauge tracking the number of bucket copies of an index the cluster still has to replicate to meet its replication factor")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkClusterFixupPendingCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterFixupPendingCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterFixupPendingCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterFixupPendingCount(cfg MetricConfig) metricSplunkClusterFixupPendingCount {
+	m := metricSplunkClusterFixupPendingCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterIndexReplicatedCopies struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.index.replicated.copies metric with initial data.
+func (m *metricSplunkClusterIndexReplicatedCopies) init() {
+	m.data.SetName("splunk.cluster.index.replicated.copies")
+	m.data.SetDescription("Gauge tracking the number of complete copies of an index's buckets held across the indexer cluster")
+	m.data.SetUnit("{copies}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkClusterIndexReplicatedCopies) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterIndexReplicatedCopies) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterIndexReplicatedCopies) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterIndexReplicatedCopies(cfg MetricConfig) metricSplunkClusterIndexReplicatedCopies {
+	m := metricSplunkClusterIndexReplicatedCopies{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterIndexSearchable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.index.searchable metric with initial data.
+func (m *metricSplunkClusterIndexSearchable) init() {
+	m.data.SetName("splunk.cluster.index.searchable")
+	m.data.SetDescription("Gauge tracking whether an index is fully searchable across the indexer cluster, 1 when it is and 0 otherwise")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkClusterIndexSearchable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterIndexSearchable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterIndexSearchable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterIndexSearchable(cfg MetricConfig) metricSplunkClusterIndexSearchable {
+	m := metricSplunkClusterIndexSearchable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterPeerFixupTasks struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.peer.fixup.tasks metric with initial data.
+func (m *metricSplunkClusterPeerFixupTasks) init() {
+	m.data.SetName("splunk.cluster.peer.fixup.tasks")
+	m.data.SetDescription("Gauge tracking the number of fixup jobs the cluster manager has pending for a peer, the peers with the most hold up the recovery of the cluster")
+	m.data.SetUnit("{tasks}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkClusterPeerFixupTasks) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkClusterPeerGUIDAttributeValue string, splunkClusterPeerNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.cluster.peer.guid", splunkClusterPeerGUIDAttributeValue)
+	dp.Attributes().PutStr("splunk.cluster.peer.name", splunkClusterPeerNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterPeerFixupTasks) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterPeerFixupTasks) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterPeerFixupTasks(cfg MetricConfig) metricSplunkClusterPeerFixupTasks {
+	m := metricSplunkClusterPeerFixupTasks{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterPeerReplicationQueueLength struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.peer.replication.queue.length metric with initial data.
+func (m *metricSplunkClusterPeerReplicationQueueLength) init() {
+	m.data.SetName("splunk.cluster.peer.replication.queue.length")
+	m.data.SetDescription("Gauge tracking the number of bucket replications a peer of the indexer cluster takes part in as source or target, a peer that keeps a long queue is holding back replication")
+	m.data.SetUnit("{replications}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkClusterPeerReplicationQueueLength) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkClusterPeerGUIDAttributeValue string, splunkClusterPeerNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.cluster.peer.guid", splunkClusterPeerGUIDAttributeValue)
+	dp.Attributes().PutStr("splunk.cluster.peer.name", splunkClusterPeerNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterPeerReplicationQueueLength) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterPeerReplicationQueueLength) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterPeerReplicationQueueLength(cfg MetricConfig) metricSplunkClusterPeerReplicationQueueLength {
+	m := metricSplunkClusterPeerReplicationQueueLength{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterPeerStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.peer.status metric with initial data.
+func (m *metricSplunkClusterPeerStatus) init() {
+	m.data.SetName("splunk.cluster.peer.status")
+	m.data.SetDescription("Gauge tracking the health of an indexer cluster peer, 1 when it is up and searchable and 0 otherwise, including while an up peer is pending becoming searchable")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkClusterPeerStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkClusterPeerGUIDAttributeValue string, splunkClusterPeerNameAttributeValue string, splunkClusterPeerStatusValueAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.cluster.peer.guid", splunkClusterPeerGUIDAttributeValue)
+	dp.Attributes().PutStr("splunk.cluster.peer.name", splunkClusterPeerNameAttributeValue)
+	dp.Attributes().PutStr("splunk.cluster.peer.status.value", splunkClusterPeerStatusValueAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterPeerStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterPeerStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterPeerStatus(cfg MetricConfig) metricSplunkClusterPeerStatus {
+	m := metricSplunkClusterPeerStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterReplicationFactor struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.replication.factor metric with initial data.
+func (m *metricSplunkClusterReplicationFactor) init() {
+	m.data.SetName("splunk.cluster.replication.factor")
+	m.data.SetDescription("Gauge tracking the number of copies of every bucket the indexer cluster is configured to keep")
+	m.data.SetUnit("{copies}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkClusterReplicationFactor) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterReplicationFactor) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterReplicationFactor) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterReplicationFactor(cfg MetricConfig) metricSplunkClusterReplicationFactor {
+	m := metricSplunkClusterReplicationFactor{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterReplicationFactorMet struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.replication.factor.met metric with initial data.
+func (m *metricSplunkClusterReplicationFactorMet) init() {
+	m.data.SetName("splunk.cluster.replication.factor.met")
+	m.data.SetDescription("Gauge tracking whether the indexer cluster meets its replication factor, 1 when it does and 0 otherwise")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkClusterReplicationFactorMet) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterReplicationFactorMet) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterReplicationFactorMet) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterReplicationFactorMet(cfg MetricConfig) metricSplunkClusterReplicationFactorMet {
+	m := metricSplunkClusterReplicationFactorMet{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterSearchFactor struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.search.factor metric with initial data.
+func (m *metricSplunkClusterSearchFactor) init() {
+	m.data.SetName("splunk.cluster.search.factor")
+	m.data.SetDescription("Gauge tracking the number of searchable copies of every bucket the indexer cluster is configured to keep")
+	m.data.SetUnit("{copies}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkClusterSearchFactor) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterSearchFactor) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterSearchFactor) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterSearchFactor(cfg MetricConfig) metricSplunkClusterSearchFactor {
+	m := metricSplunkClusterSearchFactor{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkClusterSearchFactorMet struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.cluster.search.factor.met metric with initial data.
+func (m *metricSplunkClusterSearchFactorMet) init() {
+	m.data.SetName("splunk.cluster.search.factor.met")
+	m.data.SetDescription("Gauge tracking whether the indexer cluster meets its search factor, 1 when it does and 0 otherwise")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkClusterSearchFactorMet) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkClusterSearchFactorMet) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkClusterSearchFactorMet) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkClusterSearchFactorMet(cfg MetricConfig) metricSplunkClusterSearchFactorMet {
+	m := metricSplunkClusterSearchFactorMet{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDatamodelAccelerationPercent struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.datamodel.acceleration.percent metric with initial data.
+func (m *metricSplunkDatamodelAccelerationPercent) init() {
+	m.data.SetName("splunk.datamodel.acceleration.percent")
+	m.data.SetDescription("Gauge tracking how much of the time range of an accelerated data model its summary covers")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkDatamodelAccelerationPercent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkDatamodelNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.datamodel.name", splunkDatamodelNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDatamodelAccelerationPercent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDatamodelAccelerationPercent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDatamodelAccelerationPercent(cfg MetricConfig) metricSplunkDatamodelAccelerationPercent {
+	m := metricSplunkDatamodelAccelerationPercent{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDatamodelAccelerationSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.datamodel.acceleration.size.bytes metric with initial data.
+func (m *metricSplunkDatamodelAccelerationSizeBytes) init() {
+	m.data.SetName("splunk.datamodel.acceleration.size.bytes")
+	m.data.SetDescription("Gauge tracking the disk space used by the acceleration summary of a data model")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkDatamodelAccelerationSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkDatamodelNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.datamodel.name", splunkDatamodelNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDatamodelAccelerationSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDatamodelAccelerationSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDatamodelAccelerationSizeBytes(cfg MetricConfig) metricSplunkDatamodelAccelerationSizeBytes {
+	m := metricSplunkDatamodelAccelerationSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDeploymentClientsCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.deployment.clients.count metric with initial data.
+func (m *metricSplunkDeploymentClientsCount) init() {
+	m.data.SetName("splunk.deployment.clients.count")
+	m.data.SetDescription("Gauge tracking the number of deployment clients that phoned home to this deployment server")
+	m.data.SetUnit("{clients}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkDeploymentClientsCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDeploymentClientsCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDeploymentClientsCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDeploymentClientsCount(cfg MetricConfig) metricSplunkDeploymentClientsCount {
+	m := metricSplunkDeploymentClientsCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDeploymentServerclassClients struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.deployment.serverclass.clients metric with initial data.
+func (m *metricSplunkDeploymentServerclassClients) init() {
+	m.data.SetName("splunk.deployment.serverclass.clients")
+	m.data.SetDescription("Gauge tracking the number of deployment clients that are members of a server class")
+	m.data.SetUnit("{clients}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkDeploymentServerclassClients) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkServerclassNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.serverclass.name", splunkServerclassNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDeploymentServerclassClients) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDeploymentServerclassClients) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDeploymentServerclassClients(cfg MetricConfig) metricSplunkDeploymentServerclassClients {
+	m := metricSplunkDeploymentServerclassClients{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDispatchArtifactCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.dispatch.artifact.count metric with initial data.
+func (m *metricSplunkDispatchArtifactCount) init() {
+	m.data.SetName("splunk.dispatch.artifact.count")
+	m.data.SetDescription("Gauge tracking the number of search artifacts held in the dispatch directory")
+	m.data.SetUnit("{artifacts}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkDispatchArtifactCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDispatchArtifactCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDispatchArtifactCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDispatchArtifactCount(cfg MetricConfig) metricSplunkDispatchArtifactCount {
+	m := metricSplunkDispatchArtifactCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDispatchDiskUsedBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.dispatch.disk.used.bytes metric with initial data.
+func (m *metricSplunkDispatchDiskUsedBytes) init() {
+	m.data.SetName("splunk.dispatch.disk.used.bytes")
+	m.data.SetDescription("Gauge tracking the disk space used by the search artifacts held in the dispatch directory")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkDispatchDiskUsedBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDispatchDiskUsedBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDispatchDiskUsedBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDispatchDiskUsedBytes(cfg MetricConfig) metricSplunkDispatchDiskUsedBytes {
+	m := metricSplunkDispatchDiskUsedBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkForwarderConnectionsCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.forwarder.connections.count metric with initial data.
+func (m *metricSplunkForwarderConnectionsCount) init() {
+	m.data.SetName("splunk.forwarder.connections.count")
+	m.data.SetDescription("Gauge tracking the number of connections a forwarder had open to the indexer over the last 10 minutes")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkForwarderConnectionsCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkForwarderGUIDAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.forwarder.guid", splunkForwarderGUIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkForwarderConnectionsCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkForwarderConnectionsCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkForwarderConnectionsCount(cfg MetricConfig) metricSplunkForwarderConnectionsCount {
+	m := metricSplunkForwarderConnectionsCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkForwarderDataReceivedBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.forwarder.data.received.bytes metric with initial data.
+func (m *metricSplunkForwarderDataReceivedBytes) init() {
+	m.data.SetName("splunk.forwarder.data.received.bytes")
+	m.data.SetDescription("Gauge tracking the bytes the indexer received from a forwarder over the last 10 minutes")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkForwarderDataReceivedBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkForwarderGUIDAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.forwarder.guid", splunkForwarderGUIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkForwarderDataReceivedBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkForwarderDataReceivedBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkForwarderDataReceivedBytes(cfg MetricConfig) metricSplunkForwarderDataReceivedBytes {
+	m := metricSplunkForwarderDataReceivedBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkHecDataReceivedBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.hec.data.received.bytes metric with initial data.
+func (m *metricSplunkHecDataReceivedBytes) init() {
+	m.data.SetName("splunk.hec.data.received.bytes")
+	m.data.SetDescription("Gauge tracking the bytes received by the HTTP Event Collector per token over the last 10 minutes")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkHecDataReceivedBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.hec.token.name", splunkHecTokenNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkHecDataReceivedBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkHecDataReceivedBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkHecDataReceivedBytes(cfg MetricConfig) metricSplunkHecDataReceivedBytes {
+	m := metricSplunkHecDataReceivedBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkHecErrorsCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.hec.errors.count metric with initial data.
+func (m *metricSplunkHecErrorsCount) init() {
+	m.data.SetName("splunk.hec.errors.count")
+	m.data.SetDescription("Gauge tracking the number of requests the HTTP Event Collector failed per token over the last 10 minutes")
+	m.data.SetUnit("{errors}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkHecErrorsCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.hec.token.name", splunkHecTokenNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkHecErrorsCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkHecErrorsCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkHecErrorsCount(cfg MetricConfig) metricSplunkHecErrorsCount {
+	m := metricSplunkHecErrorsCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkHecRequestsCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.hec.requests.count metric with initial data.
+func (m *metricSplunkHecRequestsCount) init() {
+	m.data.SetName("splunk.hec.requests.count")
+	m.data.SetDescription("Gauge tracking the number of requests received by the HTTP Event Collector per token over the last 10 minutes")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkHecRequestsCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.hec.token.name", splunkHecTokenNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkHecRequestsCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkHecRequestsCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkHecRequestsCount(cfg MetricConfig) metricSplunkHecRequestsCount {
+	m := metricSplunkHecRequestsCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkHecTokenEnabled struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.hec.token.enabled metric with initial data.
+func (m *metricSplunkHecTokenEnabled) init() {
+	m.data.SetName("splunk.hec.token.enabled")
+	m.data.SetDescription("Gauge tracking whether an HTTP Event Collector token accepts data, 1 when it is enabled and 0 when it was disabled")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkHecTokenEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.hec.token.name", splunkHecTokenNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkHecTokenEnabled) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkHecTokenEnabled) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkHecTokenEnabled(cfg MetricConfig) metricSplunkHecTokenEnabled {
+	m := metricSplunkHecTokenEnabled{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.bucket.count metric with initial data.
+func (m *metricSplunkIndexBucketCount) init() {
+	m.data.SetName("splunk.index.bucket.count")
+	m.data.SetDescription("Gauge tracking the number of buckets held by an index")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketCount(cfg MetricConfig) metricSplunkIndexBucketCount {
+	m := metricSplunkIndexBucketCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketsFrozenCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.buckets.frozen.count metric with initial data.
+func (m *metricSplunkIndexBucketsFrozenCount) init() {
+	m.data.SetName("splunk.index.buckets.frozen.count")
+	m.data.SetDescription("Gauge tracking the number of buckets of an index rolled to frozen over the last 10 minutes, or since the previous scrape when estimated from the API")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketsFrozenCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketsFrozenCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketsFrozenCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketsFrozenCount(cfg MetricConfig) metricSplunkIndexBucketsFrozenCount {
+	m := metricSplunkIndexBucketsFrozenCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketsRolledCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.buckets.rolled.count metric with initial data.
+func (m *metricSplunkIndexBucketsRolledCount) init() {
+	m.data.SetName("splunk.index.buckets.rolled.count")
+	m.data.SetDescription("Gauge tracking the number of buckets of an index rolled from hot to warm over the last 10 minutes, or since the previous scrape when estimated from the API")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketsRolledCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketsRolledCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketsRolledCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketsRolledCount(cfg MetricConfig) metricSplunkIndexBucketsRolledCount {
+	m := metricSplunkIndexBucketsRolledCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexEarliestEventSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.earliest.event.seconds metric with initial data.
+func (m *metricSplunkIndexEarliestEventSeconds) init() {
+	m.data.SetName("splunk.index.earliest.event.seconds")
+	m.data.SetDescription("Gauge tracking the time of the earliest event held by an index, in seconds since the epoch")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexEarliestEventSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexEarliestEventSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexEarliestEventSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexEarliestEventSeconds(cfg MetricConfig) metricSplunkIndexEarliestEventSeconds {
+	m := metricSplunkIndexEarliestEventSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexEventCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.event.count metric with initial data.
+func (m *metricSplunkIndexEventCount) init() {
+	m.data.SetName("splunk.index.event.count")
+	m.data.SetDescription("Gauge tracking the number of events held by an index")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexEventCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexEventCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexEventCount(cfg MetricConfig) metricSplunkIndexEventCount {
+	m := metricSplunkIndexEventCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexEventsSearchedRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.events.searched.rate metric with initial data.
+func (m *metricSplunkIndexEventsSearchedRate) init() {
+	m.data.SetName("splunk.index.events.searched.rate")
+	m.data.SetDescription("Gauge tracking the number of events of an index scanned by searches per second, averaged over the last 10 minutes. Searches naming several indexes count their events against each of them")
+	m.data.SetUnit("{events}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexEventsSearchedRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexEventsSearchedRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexEventsSearchedRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexEventsSearchedRate(cfg MetricConfig) metricSplunkIndexEventsSearchedRate {
+	m := metricSplunkIndexEventsSearchedRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexEventsWrittenRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.events.written.rate metric with initial data.
+func (m *metricSplunkIndexEventsWrittenRate) init() {
+	m.data.SetName("splunk.index.events.written.rate")
+	m.data.SetDescription("Gauge tracking the number of events written to an index per second, averaged over the last 10 minutes")
+	m.data.SetUnit("{events}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexEventsWrittenRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexEventsWrittenRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexEventsWrittenRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexEventsWrittenRate(cfg MetricConfig) metricSplunkIndexEventsWrittenRate {
+	m := metricSplunkIndexEventsWrittenRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexFrozenTimeSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.frozen.time.seconds metric with initial data.
+func (m *metricSplunkIndexFrozenTimeSeconds) init() {
+	m.data.SetName("splunk.index.frozen.time.seconds")
+	m.data.SetDescription("Gauge tracking the age past which the events of an index are frozen, its frozenTimePeriodInSecs setting")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexFrozenTimeSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexFrozenTimeSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexFrozenTimeSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexFrozenTimeSeconds(cfg MetricConfig) metricSplunkIndexFrozenTimeSeconds {
+	m := metricSplunkIndexFrozenTimeSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexHotBucketsCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.hot.buckets.count metric with initial data.
+func (m *metricSplunkIndexHotBucketsCount) init() {
+	m.data.SetName("splunk.index.hot.buckets.count")
+	m.data.SetDescription("Gauge tracking the number of hot buckets an index has open. Indexing into the index stalls once it reaches splunk.index.hot.buckets.max")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexHotBucketsCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexHotBucketsCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexHotBucketsCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexHotBucketsCount(cfg MetricConfig) metricSplunkIndexHotBucketsCount {
+	m := metricSplunkIndexHotBucketsCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexHotBucketsMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.hot.buckets.max metric with initial data.
+func (m *metricSplunkIndexHotBucketsMax) init() {
+	m.data.SetName("splunk.index.hot.buckets.max")
+	m.data.SetDescription("Gauge tracking the maximum number of hot buckets an index is configured to have open, its maxHotBuckets setting")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexHotBucketsMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexHotBucketsMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexHotBucketsMax) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexHotBucketsMax(cfg MetricConfig) metricSplunkIndexHotBucketsMax {
+	m := metricSplunkIndexHotBucketsMax{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexLatestEventSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.latest.event.seconds metric with initial data.
+func (m *metricSplunkIndexLatestEventSeconds) init() {
+	m.data.SetName("splunk.index.latest.event.seconds")
+	m.data.SetDescription("Gauge tracking the time of the latest event held by an index, in seconds since the epoch. Useful to tell how fresh the data of an index is")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexLatestEventSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexLatestEventSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexLatestEventSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexLatestEventSeconds(cfg MetricConfig) metricSplunkIndexLatestEventSeconds {
+	m := metricSplunkIndexLatestEventSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexMaxSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.max.size.bytes metric with initial data.
+func (m *metricSplunkIndexMaxSizeBytes) init() {
+	m.data.SetName("splunk.index.max.size.bytes")
+	m.data.SetDescription("Gauge tracking the size an index can grow to before its oldest buckets are frozen, its maxTotalDataSizeMB setting")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexMaxSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexMaxSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexMaxSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexMaxSizeBytes(cfg MetricConfig) metricSplunkIndexMaxSizeBytes {
+	m := metricSplunkIndexMaxSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexRawSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.raw.size.bytes metric with initial data.
+func (m *metricSplunkIndexRawSizeBytes) init() {
+	m.data.SetName("splunk.index.raw.size.bytes")
+	m.data.SetDescription("Gauge tracking the size of the raw data held by an index before compression")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexRawSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexRawSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexRawSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexRawSizeBytes(cfg MetricConfig) metricSplunkIndexRawSizeBytes {
+	m := metricSplunkIndexRawSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexSearchDurationSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.search.duration.seconds metric with initial data.
+func (m *metricSplunkIndexSearchDurationSeconds) init() {
+	m.data.SetName("splunk.index.search.duration.seconds")
+	m.data.SetDescription("Gauge tracking the average run time of the searches completed over the search time range that touched an index. Searches naming several indexes count against each of them")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexSearchDurationSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexSearchDurationSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexSearchDurationSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexSearchDurationSeconds(cfg MetricConfig) metricSplunkIndexSearchDurationSeconds {
+	m := metricSplunkIndexSearchDurationSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexThawedSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.thawed.size.bytes metric with initial data.
+func (m *metricSplunkIndexThawedSizeBytes) init() {
+	m.data.SetName("splunk.index.thawed.size.bytes")
+	m.data.SetDescription("Gauge tracking the disk space used by the buckets restored into the thawed path of an index")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexThawedSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexThawedSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexThawedSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexThawedSizeBytes(cfg MetricConfig) metricSplunkIndexThawedSizeBytes {
+	m := metricSplunkIndexThawedSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerIngestionLatencySeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.ingestion.latency.seconds metric with initial data.
+func (m *metricSplunkIndexerIngestionLatencySeconds) init() {
+	m.data.SetName("splunk.indexer.ingestion.latency.seconds")
+	m.data.SetDescription("Gauge tracking the delay between the time of the events of a source type indexed over the last 10 minutes and the time they were indexed, aggregated by ingestion_latency_statistic, avg by default")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerIngestionLatencySeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkSourcetypeNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.sourcetype.name", splunkSourcetypeNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerIngestionLatencySeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerIngestionLatencySeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerIngestionLatencySeconds(cfg MetricConfig) metricSplunkIndexerIngestionLatencySeconds {
+	m := metricSplunkIndexerIngestionLatencySeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerQueueLatencySeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.queue.latency.seconds metric with initial data.
+func (m *metricSplunkIndexerQueueLatencySeconds) init() {
+	m.data.SetName("splunk.indexer.queue.latency.seconds")
+	m.data.SetDescription("Gauge approximating how long data waits in each of the indexer pipeline queues, as the current size of the queue divided by the indexer throughput. Not reported while the indexer is idle")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerQueueLatencySeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkQueueNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.queue.name", splunkQueueNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerQueueLatencySeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerQueueLatencySeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerQueueLatencySeconds(cfg MetricConfig) metricSplunkIndexerQueueLatencySeconds {
+	m := metricSplunkIndexerQueueLatencySeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerQueueRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.queue.ratio metric with initial data.
+func (m *metricSplunkIndexerQueueRatio) init() {
+	m.data.SetName("splunk.indexer.queue.ratio")
+	m.data.SetDescription("Gauge tracking how full each of the indexer pipeline queues is, as a ratio of its current size to its maximum size")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerQueueRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkQueueNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.queue.name", splunkQueueNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerQueueRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerQueueRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerQueueRatio(cfg MetricConfig) metricSplunkIndexerQueueRatio {
+	m := metricSplunkIndexerQueueRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSplunkIndexerThroughput struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexer.throughput metric with initial data.
-func (m *metricSplunkIndexerThroughput) init() {
-	m.data.SetName("splunk.indexer.throughput")
-	m.data.SetDescription("Gauge tracking average bytes per second throughput of indexer")
-	m.data.SetUnit("By/s")
+// init fills splunk.indexer.throughput metric with initial data.
+func (m *metricSplunkIndexerThroughput) init() {
+	m.data.SetName("splunk.indexer.throughput")
+	m.data.SetDescription("Gauge tracking average bytes per second throughput of indexer")
+	m.data.SetUnit("By/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexerStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.indexer.status", splunkIndexerStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerThroughput) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerThroughput) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerThroughput(cfg MetricConfig) metricSplunkIndexerThroughput {
+	m := metricSplunkIndexerThroughput{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerThroughputBySourcetype struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.throughput.by_sourcetype metric with initial data.
+func (m *metricSplunkIndexerThroughputBySourcetype) init() {
+	m.data.SetName("splunk.indexer.throughput.by_sourcetype")
+	m.data.SetDescription("Gauge tracking average bytes per second throughput of indexer per source type over the last 10 minutes")
+	m.data.SetUnit("By/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerThroughputBySourcetype) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkSourcetypeNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.sourcetype.name", splunkSourcetypeNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerThroughputBySourcetype) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerThroughputBySourcetype) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerThroughputBySourcetype(cfg MetricConfig) metricSplunkIndexerThroughputBySourcetype {
+	m := metricSplunkIndexerThroughputBySourcetype{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexesCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexes.count metric with initial data.
+func (m *metricSplunkIndexesCount) init() {
+	m.data.SetName("splunk.indexes.count")
+	m.data.SetDescription("Gauge tracking the number of indexes by the type of data they hold. Sum over the types for the total number of indexes")
+	m.data.SetUnit("{indexes}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexesCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.type", splunkIndexTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexesCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexesCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexesCount(cfg MetricConfig) metricSplunkIndexesCount {
+	m := metricSplunkIndexesCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreBackupRestoreStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.backup.restore.status metric with initial data.
+func (m *metricSplunkKvstoreBackupRestoreStatus) init() {
+	m.data.SetName("splunk.kvstore.backup.restore.status")
+	m.data.SetDescription("Gauge tracking the health of KV store backup and restore, 1 when it is ready and 0 otherwise")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreBackupRestoreStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkKvstoreStatusValueAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.kvstore.status.value", splunkKvstoreStatusValueAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreBackupRestoreStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreBackupRestoreStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreBackupRestoreStatus(cfg MetricConfig) metricSplunkKvstoreBackupRestoreStatus {
+	m := metricSplunkKvstoreBackupRestoreStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreCollectionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.collection.count metric with initial data.
+func (m *metricSplunkKvstoreCollectionCount) init() {
+	m.data.SetName("splunk.kvstore.collection.count")
+	m.data.SetDescription("Gauge tracking the number of records held by a KV store collection")
+	m.data.SetUnit("{records}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreCollectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkKvstoreCollectionNameAttributeValue string, splunkAppNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.kvstore.collection.name", splunkKvstoreCollectionNameAttributeValue)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreCollectionCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreCollectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreCollectionCount(cfg MetricConfig) metricSplunkKvstoreCollectionCount {
+	m := metricSplunkKvstoreCollectionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreCollectionSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.collection.size.bytes metric with initial data.
+func (m *metricSplunkKvstoreCollectionSizeBytes) init() {
+	m.data.SetName("splunk.kvstore.collection.size.bytes")
+	m.data.SetDescription("Gauge tracking the size of the records of a KV store collection, before compression")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreCollectionSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkKvstoreCollectionNameAttributeValue string, splunkAppNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.kvstore.collection.name", splunkKvstoreCollectionNameAttributeValue)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreCollectionSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreCollectionSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreCollectionSizeBytes(cfg MetricConfig) metricSplunkKvstoreCollectionSizeBytes {
+	m := metricSplunkKvstoreCollectionSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreReplicationStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.replication.status metric with initial data.
+func (m *metricSplunkKvstoreReplicationStatus) init() {
+	m.data.SetName("splunk.kvstore.replication.status")
+	m.data.SetDescription("Gauge tracking the health of KV store replication, 1 when this member is the captain or an in sync member and 0 otherwise. Not reported on standalone instances")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreReplicationStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkKvstoreStatusValueAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.kvstore.status.value", splunkKvstoreStatusValueAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreReplicationStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreReplicationStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreReplicationStatus(cfg MetricConfig) metricSplunkKvstoreReplicationStatus {
+	m := metricSplunkKvstoreReplicationStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.status metric with initial data.
+func (m *metricSplunkKvstoreStatus) init() {
+	m.data.SetName("splunk.kvstore.status")
+	m.data.SetDescription("Gauge tracking the health of the KV store, 1 when it is ready and 0 otherwise")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkKvstoreStatusValueAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.kvstore.status.value", splunkKvstoreStatusValueAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreStatus(cfg MetricConfig) metricSplunkKvstoreStatus {
+	m := metricSplunkKvstoreStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLicenseIndexUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.license.index.usage metric with initial data.
+func (m *metricSplunkLicenseIndexUsage) init() {
+	m.data.SetName("splunk.license.index.usage")
+	m.data.SetDescription("Gauge tracking the indexed license usage per index")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkLicenseIndexUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLicenseIndexUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLicenseIndexUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLicenseIndexUsage(cfg MetricConfig) metricSplunkLicenseIndexUsage {
+	m := metricSplunkLicenseIndexUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLicensePoolQuotaBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.license.pool.quota.bytes metric with initial data.
+func (m *metricSplunkLicensePoolQuotaBytes) init() {
+	m.data.SetName("splunk.license.pool.quota.bytes")
+	m.data.SetDescription("Gauge tracking the daily license volume allotted to a license pool. Not reported for pools drawing on the whole stack quota")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkLicensePoolQuotaBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkLicensePoolNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.license.pool.name", splunkLicensePoolNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLicensePoolQuotaBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLicensePoolQuotaBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLicensePoolQuotaBytes(cfg MetricConfig) metricSplunkLicensePoolQuotaBytes {
+	m := metricSplunkLicensePoolQuotaBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLicensePoolUsedBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.license.pool.used.bytes metric with initial data.
+func (m *metricSplunkLicensePoolUsedBytes) init() {
+	m.data.SetName("splunk.license.pool.used.bytes")
+	m.data.SetDescription("Gauge tracking the license volume used today by a license pool")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkLicensePoolUsedBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkLicensePoolNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.license.pool.name", splunkLicensePoolNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLicensePoolUsedBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLicensePoolUsedBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLicensePoolUsedBytes(cfg MetricConfig) metricSplunkLicensePoolUsedBytes {
+	m := metricSplunkLicensePoolUsedBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLicenseSlaveCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.license.slave.count metric with initial data.
+func (m *metricSplunkLicenseSlaveCount) init() {
+	m.data.SetName("splunk.license.slave.count")
+	m.data.SetDescription("Gauge tracking the number of license peers reporting to the license manager")
+	m.data.SetUnit("{peers}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkLicenseSlaveCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLicenseSlaveCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLicenseSlaveCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLicenseSlaveCount(cfg MetricConfig) metricSplunkLicenseSlaveCount {
+	m := metricSplunkLicenseSlaveCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLicenseViolation struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.license.violation metric with initial data.
+func (m *metricSplunkLicenseViolation) init() {
+	m.data.SetName("splunk.license.violation")
+	m.data.SetDescription("Gauge tracking whether the license manager reports a license violation, 1 when it reports an error level message and 0 otherwise")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkLicenseViolation) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLicenseViolation) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLicenseViolation) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLicenseViolation(cfg MetricConfig) metricSplunkLicenseViolation {
+	m := metricSplunkLicenseViolation{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLicenseWarningCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.license.warning.count metric with initial data.
+func (m *metricSplunkLicenseWarningCount) init() {
+	m.data.SetName("splunk.license.warning.count")
+	m.data.SetDescription("Gauge tracking the number of license warnings the license manager currently reports for a license pool. Warnings not tied to a pool are reported with an empty pool name")
+	m.data.SetUnit("{warnings}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkLicenseWarningCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkLicensePoolNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.license.pool.name", splunkLicensePoolNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLicenseWarningCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLicenseWarningCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLicenseWarningCount(cfg MetricConfig) metricSplunkLicenseWarningCount {
+	m := metricSplunkLicenseWarningCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkPipelineCPUSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.pipeline.cpu.seconds metric with initial data.
+func (m *metricSplunkPipelineCPUSeconds) init() {
+	m.data.SetName("splunk.pipeline.cpu.seconds")
+	m.data.SetDescription("Gauge tracking the CPU time spent by an indexer pipeline processor over the last 10 minutes")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkPipelineCPUSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkPipelineNameAttributeValue string, splunkPipelineProcessorNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.pipeline.name", splunkPipelineNameAttributeValue)
+	dp.Attributes().PutStr("splunk.pipeline.processor.name", splunkPipelineProcessorNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkPipelineCPUSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkPipelineCPUSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkPipelineCPUSeconds(cfg MetricConfig) metricSplunkPipelineCPUSeconds {
+	m := metricSplunkPipelineCPUSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkProcessCPUPercent struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.process.cpu.percent metric with initial data.
+func (m *metricSplunkProcessCPUPercent) init() {
+	m.data.SetName("splunk.process.cpu.percent")
+	m.data.SetDescription("Gauge tracking the percentage of CPU used by the Splunk processes sharing a name")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkProcessCPUPercent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkProcessNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.process.name", splunkProcessNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkProcessCPUPercent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkProcessCPUPercent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkProcessCPUPercent(cfg MetricConfig) metricSplunkProcessCPUPercent {
+	m := metricSplunkProcessCPUPercent{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkProcessMemoryBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.process.memory.bytes metric with initial data.
+func (m *metricSplunkProcessMemoryBytes) init() {
+	m.data.SetName("splunk.process.memory.bytes")
+	m.data.SetDescription("Gauge tracking the memory used by the Splunk processes sharing a name")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkProcessMemoryBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkProcessNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.process.name", splunkProcessNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkProcessMemoryBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkProcessMemoryBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkProcessMemoryBytes(cfg MetricConfig) metricSplunkProcessMemoryBytes {
+	m := metricSplunkProcessMemoryBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverSearchWaitSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.search.wait.seconds metric with initial data.
+func (m *metricSplunkReceiverSearchWaitSeconds) init() {
+	m.data.SetName("splunk.receiver.search.wait.seconds")
+	m.data.SetDescription("Gauge tracking how long the receiver waited on the last run of a search it dispatched, from dispatch until its results were ready. Useful for tuning max_search_wait_time")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverSearchWaitSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkSearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.search.name", splunkSearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverSearchWaitSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverSearchWaitSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverSearchWaitSeconds(cfg MetricConfig) metricSplunkReceiverSearchWaitSeconds {
+	m := metricSplunkReceiverSearchWaitSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSavedsearchAlertFiredCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.savedsearch.alert.fired.count metric with initial data.
+func (m *metricSplunkSavedsearchAlertFiredCount) init() {
+	m.data.SetName("splunk.savedsearch.alert.fired.count")
+	m.data.SetDescription("Gauge tracking the number of times a saved search fired its alert over the last 10 minutes")
+	m.data.SetUnit("{alerts}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSavedsearchAlertFiredCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSavedsearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.savedsearch.name", splunkSavedsearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSavedsearchAlertFiredCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSavedsearchAlertFiredCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSavedsearchAlertFiredCount(cfg MetricConfig) metricSplunkSavedsearchAlertFiredCount {
+	m := metricSplunkSavedsearchAlertFiredCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSavedsearchAlertSuppressedCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.savedsearch.alert.suppressed.count metric with initial data.
+func (m *metricSplunkSavedsearchAlertSuppressedCount) init() {
+	m.data.SetName("splunk.savedsearch.alert.suppressed.count")
+	m.data.SetDescription("Gauge tracking the number of times the alert of a saved search was suppressed by its throttling settings over the last 10 minutes")
+	m.data.SetUnit("{alerts}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSavedsearchAlertSuppressedCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSavedsearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.savedsearch.name", splunkSavedsearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSavedsearchAlertSuppressedCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSavedsearchAlertSuppressedCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSavedsearchAlertSuppressedCount(cfg MetricConfig) metricSplunkSavedsearchAlertSuppressedCount {
+	m := metricSplunkSavedsearchAlertSuppressedCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerExecutionDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.execution.duration metric with initial data.
+func (m *metricSplunkSchedulerExecutionDuration) init() {
+	m.data.SetName("splunk.scheduler.execution.duration")
+	m.data.SetDescription("Gauge tracking the average run time of a saved search over the last 10 minutes")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerExecutionDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkSavedsearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.savedsearch.name", splunkSavedsearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerExecutionDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerExecutionDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerExecutionDuration(cfg MetricConfig) metricSplunkSchedulerExecutionDuration {
+	m := metricSplunkSchedulerExecutionDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerLagSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.lag.seconds metric with initial data.
+func (m *metricSplunkSchedulerLagSeconds) init() {
+	m.data.SetName("splunk.scheduler.lag.seconds")
+	m.data.SetDescription("Gauge tracking the average delay between a saved search's scheduled time and its dispatch over the last 10 minutes")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerLagSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkSavedsearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.savedsearch.name", splunkSavedsearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerLagSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerLagSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerLagSeconds(cfg MetricConfig) metricSplunkSchedulerLagSeconds {
+	m := metricSplunkSchedulerLagSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerOldestQueuedSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.oldest.queued.seconds metric with initial data.
+func (m *metricSplunkSchedulerOldestQueuedSeconds) init() {
+	m.data.SetName("splunk.scheduler.oldest.queued.seconds")
+	m.data.SetDescription("Gauge tracking how long the search that has been queued the longest has been waiting to run, 0 when no search is queued")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSchedulerOldestQueuedSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerOldestQueuedSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerOldestQueuedSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerOldestQueuedSeconds(cfg MetricConfig) metricSplunkSchedulerOldestQueuedSeconds {
+	m := metricSplunkSchedulerOldestQueuedSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerSkippedCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.skipped.count metric with initial data.
+func (m *metricSplunkSchedulerSkippedCount) init() {
+	m.data.SetName("splunk.scheduler.skipped.count")
+	m.data.SetDescription("Gauge tracking the number of times a saved search was skipped by the scheduler over the last 10 minutes, by the reason it was skipped for")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerSkippedCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSavedsearchNameAttributeValue string, splunkSchedulerSkipReasonAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.savedsearch.name", splunkSavedsearchNameAttributeValue)
+	dp.Attributes().PutStr("splunk.scheduler.skip.reason", splunkSchedulerSkipReasonAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerSkippedCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerSkippedCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerSkippedCount(cfg MetricConfig) metricSplunkSchedulerSkippedCount {
+	m := metricSplunkSchedulerSkippedCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchCacheHitRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.search.cache.hit.ratio metric with initial data.
+func (m *metricSplunkSearchCacheHitRatio) init() {
+	m.data.SetName("splunk.search.cache.hit.ratio")
+	m.data.SetDescription("Gauge tracking the fraction of the lookups of the search results cache that found the results of the search in it since splunkd started")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSearchCacheHitRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchCacheHitRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchCacheHitRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchCacheHitRatio(cfg MetricConfig) metricSplunkSearchCacheHitRatio {
+	m := metricSplunkSearchCacheHitRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.search.count metric with initial data.
+func (m *metricSplunkSearchCount) init() {
+	m.data.SetName("splunk.search.count")
+	m.data.SetDescription("Gauge tracking the number of searches run from an app over the last 10 minutes")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSearchCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchCount(cfg MetricConfig) metricSplunkSearchCount {
+	m := metricSplunkSearchCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchEventCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.search.event.count metric with initial data.
+func (m *metricSplunkSearchEventCount) init() {
+	m.data.SetName("splunk.search.event.count")
+	m.data.SetDescription("Gauge tracking the number of events returned by the last run of a search dispatched by the receiver")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSearchEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.search.name", splunkSearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchEventCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchEventCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchEventCount(cfg MetricConfig) metricSplunkSearchEventCount {
+	m := metricSplunkSearchEventCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchRunDurationSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.search.run.duration.seconds metric with initial data.
+func (m *metricSplunkSearchRunDurationSeconds) init() {
+	m.data.SetName("splunk.search.run.duration.seconds")
+	m.data.SetDescription("Gauge tracking how long the last run of a search dispatched by the receiver took to complete")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSearchRunDurationSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkSearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.search.name", splunkSearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchRunDurationSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchRunDurationSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchRunDurationSeconds(cfg MetricConfig) metricSplunkSearchRunDurationSeconds {
+	m := metricSplunkSearchRunDurationSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchScanCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.search.scan.count metric with initial data.
+func (m *metricSplunkSearchScanCount) init() {
+	m.data.SetName("splunk.search.scan.count")
+	m.data.SetDescription("Gauge tracking the number of events scanned by the last run of a search dispatched by the receiver")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSearchScanCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.search.name", splunkSearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchScanCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchScanCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchScanCount(cfg MetricConfig) metricSplunkSearchScanCount {
+	m := metricSplunkSearchScanCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchTimeoutCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.search.timeout.count metric with initial data.
+func (m *metricSplunkSearchTimeoutCount) init() {
+	m.data.SetName("splunk.search.timeout.count")
+	m.data.SetDescription("Number of times a search dispatched by the receiver was given up on for exceeding max_search_wait_time since the receiver started")
+	m.data.SetUnit("{timeouts}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSearchTimeoutCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSearchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.search.name", splunkSearchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchTimeoutCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchTimeoutCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchTimeoutCount(cfg MetricConfig) metricSplunkSearchTimeoutCount {
+	m := metricSplunkSearchTimeoutCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchesLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.searches.limit metric with initial data.
+func (m *metricSplunkSearchesLimit) init() {
+	m.data.SetName("splunk.searches.limit")
+	m.data.SetDescription("Gauge tracking the number of historical searches the instance runs at once before it starts queueing them")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSearchesLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchesLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchesLimit) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchesLimit(cfg MetricConfig) metricSplunkSearchesLimit {
+	m := metricSplunkSearchesLimit{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchesQueuedCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.searches.queued.count metric with initial data.
+func (m *metricSplunkSearchesQueuedCount) init() {
+	m.data.SetName("splunk.searches.queued.count")
+	m.data.SetDescription("Gauge tracking the number of searches waiting for the instance to run them")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSearchesQueuedCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchesQueuedCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchesQueuedCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchesQueuedCount(cfg MetricConfig) metricSplunkSearchesQueuedCount {
+	m := metricSplunkSearchesQueuedCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchesRealtimeLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.searches.realtime.limit metric with initial data.
+func (m *metricSplunkSearchesRealtimeLimit) init() {
+	m.data.SetName("splunk.searches.realtime.limit")
+	m.data.SetDescription("Gauge tracking the number of real-time searches the instance runs at once before it starts queueing them")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSearchesRealtimeLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchesRealtimeLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchesRealtimeLimit) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchesRealtimeLimit(cfg MetricConfig) metricSplunkSearchesRealtimeLimit {
+	m := metricSplunkSearchesRealtimeLimit{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchesRealtimeRunningCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.searches.realtime.running.count metric with initial data.
+func (m *metricSplunkSearchesRealtimeRunningCount) init() {
+	m.data.SetName("splunk.searches.realtime.running.count")
+	m.data.SetDescription("Gauge tracking the number of real-time searches running on the instance")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSearchesRealtimeRunningCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchesRealtimeRunningCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchesRealtimeRunningCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchesRealtimeRunningCount(cfg MetricConfig) metricSplunkSearchesRealtimeRunningCount {
+	m := metricSplunkSearchesRealtimeRunningCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSearchesRunningCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.searches.running.count metric with initial data.
+func (m *metricSplunkSearchesRunningCount) init() {
+	m.data.SetName("splunk.searches.running.count")
+	m.data.SetDescription("Gauge tracking the number of searches running on the instance")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSearchesRunningCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSearchesRunningCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSearchesRunningCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSearchesRunningCount(cfg MetricConfig) metricSplunkSearchesRunningCount {
+	m := metricSplunkSearchesRunningCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkServerCPUUsagePercent struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.server.cpu.usage.percent metric with initial data.
+func (m *metricSplunkServerCPUUsagePercent) init() {
+	m.data.SetName("splunk.server.cpu.usage.percent")
+	m.data.SetDescription("Gauge tracking the percentage of CPU in use on the host, as seen by Splunk")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkServerCPUUsagePercent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkServerCPUUsagePercent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkServerCPUUsagePercent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkServerCPUUsagePercent(cfg MetricConfig) metricSplunkServerCPUUsagePercent {
+	m := metricSplunkServerCPUUsagePercent{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkServerMemoryUsageBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.server.memory.usage.bytes metric with initial data.
+func (m *metricSplunkServerMemoryUsageBytes) init() {
+	m.data.SetName("splunk.server.memory.usage.bytes")
+	m.data.SetDescription("Gauge tracking the memory in use on the host, as seen by Splunk")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkServerMemoryUsageBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkServerMemoryUsageBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkServerMemoryUsageBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkServerMemoryUsageBytes(cfg MetricConfig) metricSplunkServerMemoryUsageBytes {
+	m := metricSplunkServerMemoryUsageBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkServerPartitionCapacityBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.server.partition.capacity.bytes metric with initial data.
+func (m *metricSplunkServerPartitionCapacityBytes) init() {
+	m.data.SetName("splunk.server.partition.capacity.bytes")
+	m.data.SetDescription("Gauge tracking the size of a partition Splunk writes to")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkServerPartitionCapacityBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPartitionMountpointAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.partition.mountpoint", splunkPartitionMountpointAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkServerPartitionCapacityBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkServerPartitionCapacityBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkServerPartitionCapacityBytes(cfg MetricConfig) metricSplunkServerPartitionCapacityBytes {
+	m := metricSplunkServerPartitionCapacityBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkServerPartitionFreeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.server.partition.free.bytes metric with initial data.
+func (m *metricSplunkServerPartitionFreeBytes) init() {
+	m.data.SetName("splunk.server.partition.free.bytes")
+	m.data.SetDescription("Gauge tracking the space left on a partition Splunk writes to. Splunk stops indexing once it drops below the minFreeSpace setting of server.conf")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkServerPartitionFreeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPartitionMountpointAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.partition.mountpoint", splunkPartitionMountpointAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkServerPartitionFreeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkServerPartitionFreeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkServerPartitionFreeBytes(cfg MetricConfig) metricSplunkServerPartitionFreeBytes {
+	m := metricSplunkServerPartitionFreeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkServerPartitionUsedBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.server.partition.used.bytes metric with initial data.
+func (m *metricSplunkServerPartitionUsedBytes) init() {
+	m.data.SetName("splunk.server.partition.used.bytes")
+	m.data.SetDescription("Gauge tracking the space used on a partition Splunk writes to")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkServerPartitionUsedBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPartitionMountpointAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.partition.mountpoint", splunkPartitionMountpointAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkServerPartitionUsedBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkServerPartitionUsedBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkServerPartitionUsedBytes(cfg MetricConfig) metricSplunkServerPartitionUsedBytes {
+	m := metricSplunkServerPartitionUsedBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkShcArtifactReplicationFailures struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.shc.artifact.replication.failures metric with initial data.
+func (m *metricSplunkShcArtifactReplicationFailures) init() {
+	m.data.SetName("splunk.shc.artifact.replication.failures")
+	m.data.SetDescription("Gauge tracking the number of artifact replication jobs targeting a search head cluster member that the captain lists as failed")
+	m.data.SetUnit("{jobs}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkShcArtifactReplicationFailures) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkShcPeerGUIDAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.shc.peer.guid", splunkShcPeerGUIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkShcArtifactReplicationFailures) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkShcArtifactReplicationFailures) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkShcArtifactReplicationFailures(cfg MetricConfig) metricSplunkShcArtifactReplicationFailures {
+	m := metricSplunkShcArtifactReplicationFailures{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkShcCaptainElectionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.shc.captain.election.count metric with initial data.
+func (m *metricSplunkShcCaptainElectionCount) init() {
+	m.data.SetName("splunk.shc.captain.election.count")
+	m.data.SetDescription("Number of search head cluster captain elections observed since the receiver started")
+	m.data.SetUnit("{elections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSplunkShcCaptainElectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkShcCaptainElectionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkShcCaptainElectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkShcCaptainElectionCount(cfg MetricConfig) metricSplunkShcCaptainElectionCount {
+	m := metricSplunkShcCaptainElectionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkShcMemberStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.shc.member.status metric with initial data.
+func (m *metricSplunkShcMemberStatus) init() {
+	m.data.SetName("splunk.shc.member.status")
+	m.data.SetDescription("Gauge tracking the health of this search head cluster member, 1 when it is up and 0 otherwise")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkShcMemberStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkShcMemberStatusValueAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.shc.member.status.value", splunkShcMemberStatusValueAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkShcMemberStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkShcMemberStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkShcMemberStatus(cfg MetricConfig) metricSplunkShcMemberStatus {
+	m := metricSplunkShcMemberStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkShcReplicationPendingCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.shc.replication.pending.count metric with initial data.
+func (m *metricSplunkShcReplicationPendingCount) init() {
+	m.data.SetName("splunk.shc.replication.pending.count")
+	m.data.SetDescription("Gauge tracking the number of replication jobs the captain has pending for a search head cluster member")
+	m.data.SetUnit("{jobs}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkShcReplicationPendingCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkShcPeerGUIDAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.shc.peer.guid", splunkShcPeerGUIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkShcReplicationPendingCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkShcReplicationPendingCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkShcReplicationPendingCount(cfg MetricConfig) metricSplunkShcReplicationPendingCount {
+	m := metricSplunkShcReplicationPendingCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkShcReplicationStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.shc.replication.status metric with initial data.
+func (m *metricSplunkShcReplicationStatus) init() {
+	m.data.SetName("splunk.shc.replication.status")
+	m.data.SetDescription("Gauge tracking whether this member takes part in search artifact replication, 1 when it is registered with the captain and replicating and 0 otherwise")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkShcReplicationStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkShcReplicationStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkShcReplicationStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkShcReplicationStatus(cfg MetricConfig) metricSplunkShcReplicationStatus {
+	m := metricSplunkShcReplicationStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSmartstoreCacheHitRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.smartstore.cache.hit.ratio metric with initial data.
+func (m *metricSplunkSmartstoreCacheHitRatio) init() {
+	m.data.SetName("splunk.smartstore.cache.hit.ratio")
+	m.data.SetDescription("Gauge tracking the fraction of the lookups of the SmartStore cache of an index that found the bucket in the cache since splunkd started")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSmartstoreCacheHitRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSmartstoreCacheHitRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSmartstoreCacheHitRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSmartstoreCacheHitRatio(cfg MetricConfig) metricSplunkSmartstoreCacheHitRatio {
+	m := metricSplunkSmartstoreCacheHitRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSmartstoreEvictionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.smartstore.eviction.count metric with initial data.
+func (m *metricSplunkSmartstoreEvictionCount) init() {
+	m.data.SetName("splunk.smartstore.eviction.count")
+	m.data.SetDescription("Number of buckets of a SmartStore index evicted from the cache since splunkd started")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSmartstoreEvictionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSmartstoreEvictionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSmartstoreEvictionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSmartstoreEvictionCount(cfg MetricConfig) metricSplunkSmartstoreEvictionCount {
+	m := metricSplunkSmartstoreEvictionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSmartstoreUploadPendingCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.smartstore.upload.pending.count metric with initial data.
+func (m *metricSplunkSmartstoreUploadPendingCount) init() {
+	m.data.SetName("splunk.smartstore.upload.pending.count")
+	m.data.SetDescription("Gauge tracking the number of buckets of a SmartStore index waiting to be uploaded to remote storage")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSmartstoreUploadPendingCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSmartstoreUploadPendingCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSmartstoreUploadPendingCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSmartstoreUploadPendingCount(cfg MetricConfig) metricSplunkSmartstoreUploadPendingCount {
+	m := metricSplunkSmartstoreUploadPendingCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSourcetypeEventCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.sourcetype.event.count metric with initial data.
+func (m *metricSplunkSourcetypeEventCount) init() {
+	m.data.SetName("splunk.sourcetype.event.count")
+	m.data.SetDescription("Gauge tracking the number of events indexed per source type over the search time range, all time unless search_earliest_time or search_latest_time is set")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSourcetypeEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSourcetypeNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.sourcetype.name", splunkSourcetypeNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSourcetypeEventCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSourcetypeEventCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSourcetypeEventCount(cfg MetricConfig) metricSplunkSourcetypeEventCount {
+	m := metricSplunkSourcetypeEventCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkUp struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.up metric with initial data.
+func (m *metricSplunkUp) init() {
+	m.data.SetName("splunk.up")
+	m.data.SetDescription("Gauge tracking whether the Splunk management port answered during the scrape, 1 when it did and 0 otherwise")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkUp) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkUp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkUp) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkUp(cfg MetricConfig) metricSplunkUp {
+	m := metricSplunkUp{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkUserDispatchQuotaLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.user.dispatch.quota.limit metric with initial data.
+func (m *metricSplunkUserDispatchQuotaLimit) init() {
+	m.data.SetName("splunk.user.dispatch.quota.limit")
+	m.data.SetDescription("Gauge tracking the number of historical searches a user can run at once, the highest srchJobsQuota of their roles")
+	m.data.SetUnit("{searches}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexerThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexerStatusAttributeValue string) {
+func (m *metricSplunkUserDispatchQuotaLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkUserNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.indexer.status", splunkIndexerStatusAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.user.name", splunkUserNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexerThroughput) updateCapacity() {
+func (m *metricSplunkUserDispatchQuotaLimit) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexerThroughput) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkUserDispatchQuotaLimit) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -53,8 +4764,8 @@ func (m *metricSplunkIndexerThroughput) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexerThroughput(cfg MetricConfig) metricSplunkIndexerThroughput {
-	m := metricSplunkIndexerThroughput{config: cfg}
+func newMetricSplunkUserDispatchQuotaLimit(cfg MetricConfig) metricSplunkUserDispatchQuotaLimit {
+	m := metricSplunkUserDispatchQuotaLimit{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -62,22 +4773,22 @@ func newMetricSplunkIndexerThroughput(cfg MetricConfig) metricSplunkIndexerThrou
 	return m
 }
 
-type metricSplunkLicenseIndexUsage struct {
+type metricSplunkUserDispatchQuotaUsed struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.license.index.usage metric with initial data.
-func (m *metricSplunkLicenseIndexUsage) init() {
-	m.data.SetName("splunk.license.index.usage")
-	m.data.SetDescription("Gauge tracking the indexed license usage per index")
-	m.data.SetUnit("By")
+// init fills splunk.user.dispatch.quota.used metric with initial data.
+func (m *metricSplunkUserDispatchQuotaUsed) init() {
+	m.data.SetName("splunk.user.dispatch.quota.used")
+	m.data.SetDescription("Gauge tracking the number of historical searches a user is running against their search job quota")
+	m.data.SetUnit("{searches}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkLicenseIndexUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkUserDispatchQuotaUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkUserNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -85,18 +4796,18 @@ func (m *metricSplunkLicenseIndexUsage) recordDataPoint(start pcommon.Timestamp,
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.user.name", splunkUserNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkLicenseIndexUsage) updateCapacity() {
+func (m *metricSplunkUserDispatchQuotaUsed) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkLicenseIndexUsage) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkUserDispatchQuotaUsed) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -104,8 +4815,8 @@ func (m *metricSplunkLicenseIndexUsage) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkLicenseIndexUsage(cfg MetricConfig) metricSplunkLicenseIndexUsage {
-	m := metricSplunkLicenseIndexUsage{config: cfg}
+func newMetricSplunkUserDispatchQuotaUsed(cfg MetricConfig) metricSplunkUserDispatchQuotaUsed {
+	m := metricSplunkUserDispatchQuotaUsed{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -116,13 +4827,106 @@ func newMetricSplunkLicenseIndexUsage(cfg MetricConfig) metricSplunkLicenseIndex
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                        MetricsBuilderConfig // config of the metrics builder.
-	startTime                     pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity               int                  // maximum observed number of metrics per resource.
-	metricsBuffer                 pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                     component.BuildInfo  // contains version information.
-	metricSplunkIndexerThroughput metricSplunkIndexerThroughput
-	metricSplunkLicenseIndexUsage metricSplunkLicenseIndexUsage
+	config                                        MetricsBuilderConfig // config of the metrics builder.
+	startTime                                     pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                               int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                 pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                     component.BuildInfo  // contains version information.
+	metricSplunkAlertsTriggeredCount              metricSplunkAlertsTriggeredCount
+	metricSplunkAlertsTriggeredOldestSeconds      metricSplunkAlertsTriggeredOldestSeconds
+	metricSplunkAuthFailuresCount                 metricSplunkAuthFailuresCount
+	metricSplunkClusterFixupPendingCount          metricSplunkClusterFixupPendingCount
+	metricSplunkClusterIndexReplicatedCopies      metricSplunkClusterIndexReplicatedCopies
+	metricSplunkClusterIndexSearchable            metricSplunkClusterIndexSearchable
+	metricSplunkClusterPeerFixupTasks             metricSplunkClusterPeerFixupTasks
+	metricSplunkClusterPeerReplicationQueueLength metricSplunkClusterPeerReplicationQueueLength
+	metricSplunkClusterPeerStatus                 metricSplunkClusterPeerStatus
+	metricSplunkClusterReplicationFactor          metricSplunkClusterReplicationFactor
+	metricSplunkClusterReplicationFactorMet       metricSplunkClusterReplicationFactorMet
+	metricSplunkClusterSearchFactor               metricSplunkClusterSearchFactor
+	metricSplunkClusterSearchFactorMet            metricSplunkClusterSearchFactorMet
+	metricSplunkDatamodelAccelerationPercent      metricSplunkDatamodelAccelerationPercent
+	metricSplunkDatamodelAccelerationSizeBytes    metricSplunkDatamodelAccelerationSizeBytes
+	metricSplunkDeploymentClientsCount            metricSplunkDeploymentClientsCount
+	metricSplunkDeploymentServerclassClients      metricSplunkDeploymentServerclassClients
+	metricSplunkDispatchArtifactCount             metricSplunkDispatchArtifactCount
+	metricSplunkDispatchDiskUsedBytes             metricSplunkDispatchDiskUsedBytes
+	metricSplunkForwarderConnectionsCount         metricSplunkForwarderConnectionsCount
+	metricSplunkForwarderDataReceivedBytes        metricSplunkForwarderDataReceivedBytes
+	metricSplunkHecDataReceivedBytes              metricSplunkHecDataReceivedBytes
+	metricSplunkHecErrorsCount                    metricSplunkHecErrorsCount
+	metricSplunkHecRequestsCount                  metricSplunkHecRequestsCount
+	metricSplunkHecTokenEnabled                   metricSplunkHecTokenEnabled
+	metricSplunkIndexBucketCount                  metricSplunkIndexBucketCount
+	metricSplunkIndexBucketsFrozenCount           metricSplunkIndexBucketsFrozenCount
+	metricSplunkIndexBucketsRolledCount           metricSplunkIndexBucketsRolledCount
+	metricSplunkIndexEarliestEventSeconds         metricSplunkIndexEarliestEventSeconds
+	metricSplunkIndexEventCount                   metricSplunkIndexEventCount
+	metricSplunkIndexEventsSearchedRate           metricSplunkIndexEventsSearchedRate
+	metricSplunkIndexEventsWrittenRate            metricSplunkIndexEventsWrittenRate
+	metricSplunkIndexFrozenTimeSeconds            metricSplunkIndexFrozenTimeSeconds
+	metricSplunkIndexHotBucketsCount              metricSplunkIndexHotBucketsCount
+	metricSplunkIndexHotBucketsMax                metricSplunkIndexHotBucketsMax
+	metricSplunkIndexLatestEventSeconds           metricSplunkIndexLatestEventSeconds
+	metricSplunkIndexMaxSizeBytes                 metricSplunkIndexMaxSizeBytes
+	metricSplunkIndexRawSizeBytes                 metricSplunkIndexRawSizeBytes
+	metricSplunkIndexSearchDurationSeconds        metricSplunkIndexSearchDurationSeconds
+	metricSplunkIndexThawedSizeBytes              metricSplunkIndexThawedSizeBytes
+	metricSplunkIndexerIngestionLatencySeconds    metricSplunkIndexerIngestionLatencySeconds
+	metricSplunkIndexerQueueLatencySeconds        metricSplunkIndexerQueueLatencySeconds
+	metricSplunkIndexerQueueRatio                 metricSplunkIndexerQueueRatio
+	metricSplunkIndexerThroughput                 metricSplunkIndexerThroughput
+	metricSplunkIndexerThroughputBySourcetype     metricSplunkIndexerThroughputBySourcetype
+	metricSplunkIndexesCount                      metricSplunkIndexesCount
+	metricSplunkKvstoreBackupRestoreStatus        metricSplunkKvstoreBackupRestoreStatus
+	metricSplunkKvstoreCollectionCount            metricSplunkKvstoreCollectionCount
+	metricSplunkKvstoreCollectionSizeBytes        metricSplunkKvstoreCollectionSizeBytes
+	metricSplunkKvstoreReplicationStatus          metricSplunkKvstoreReplicationStatus
+	metricSplunkKvstoreStatus                     metricSplunkKvstoreStatus
+	metricSplunkLicenseIndexUsage                 metricSplunkLicenseIndexUsage
+	metricSplunkLicensePoolQuotaBytes             metricSplunkLicensePoolQuotaBytes
+	metricSplunkLicensePoolUsedBytes              metricSplunkLicensePoolUsedBytes
+	metricSplunkLicenseSlaveCount                 metricSplunkLicenseSlaveCount
+	metricSplunkLicenseViolation                  metricSplunkLicenseViolation
+	metricSplunkLicenseWarningCount               metricSplunkLicenseWarningCount
+	metricSplunkPipelineCPUSeconds                metricSplunkPipelineCPUSeconds
+	metricSplunkProcessCPUPercent                 metricSplunkProcessCPUPercent
+	metricSplunkProcessMemoryBytes                metricSplunkProcessMemoryBytes
+	metricSplunkReceiverSearchWaitSeconds         metricSplunkReceiverSearchWaitSeconds
+	metricSplunkSavedsearchAlertFiredCount        metricSplunkSavedsearchAlertFiredCount
+	metricSplunkSavedsearchAlertSuppressedCount   metricSplunkSavedsearchAlertSuppressedCount
+	metricSplunkSchedulerExecutionDuration        metricSplunkSchedulerExecutionDuration
+	metricSplunkSchedulerLagSeconds               metricSplunkSchedulerLagSeconds
+	metricSplunkSchedulerOldestQueuedSeconds      metricSplunkSchedulerOldestQueuedSeconds
+	metricSplunkSchedulerSkippedCount             metricSplunkSchedulerSkippedCount
+	metricSplunkSearchCacheHitRatio               metricSplunkSearchCacheHitRatio
+	metricSplunkSearchCount                       metricSplunkSearchCount
+	metricSplunkSearchEventCount                  metricSplunkSearchEventCount
+	metricSplunkSearchRunDurationSeconds          metricSplunkSearchRunDurationSeconds
+	metricSplunkSearchScanCount                   metricSplunkSearchScanCount
+	metricSplunkSearchTimeoutCount                metricSplunkSearchTimeoutCount
+	metricSplunkSearchesLimit                     metricSplunkSearchesLimit
+	metricSplunkSearchesQueuedCount               metricSplunkSearchesQueuedCount
+	metricSplunkSearchesRealtimeLimit             metricSplunkSearchesRealtimeLimit
+	metricSplunkSearchesRealtimeRunningCount      metricSplunkSearchesRealtimeRunningCount
+	metricSplunkSearchesRunningCount              metricSplunkSearchesRunningCount
+	metricSplunkServerCPUUsagePercent             metricSplunkServerCPUUsagePercent
+	metricSplunkServerMemoryUsageBytes            metricSplunkServerMemoryUsageBytes
+	metricSplunkServerPartitionCapacityBytes      metricSplunkServerPartitionCapacityBytes
+	metricSplunkServerPartitionFreeBytes          metricSplunkServerPartitionFreeBytes
+	metricSplunkServerPartitionUsedBytes          metricSplunkServerPartitionUsedBytes
+	metricSplunkShcArtifactReplicationFailures    metricSplunkShcArtifactReplicationFailures
+	metricSplunkShcCaptainElectionCount           metricSplunkShcCaptainElectionCount
+	metricSplunkShcMemberStatus                   metricSplunkShcMemberStatus
+	metricSplunkShcReplicationPendingCount        metricSplunkShcReplicationPendingCount
+	metricSplunkShcReplicationStatus              metricSplunkShcReplicationStatus
+	metricSplunkSmartstoreCacheHitRatio           metricSplunkSmartstoreCacheHitRatio
+	metricSplunkSmartstoreEvictionCount           metricSplunkSmartstoreEvictionCount
+	metricSplunkSmartstoreUploadPendingCount      metricSplunkSmartstoreUploadPendingCount
+	metricSplunkSourcetypeEventCount              metricSplunkSourcetypeEventCount
+	metricSplunkUp                                metricSplunkUp
+	metricSplunkUserDispatchQuotaLimit            metricSplunkUserDispatchQuotaLimit
+	metricSplunkUserDispatchQuotaUsed             metricSplunkUserDispatchQuotaUsed
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -137,12 +4941,105 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                        mbc,
-		startTime:                     pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                 pmetric.NewMetrics(),
-		buildInfo:                     settings.BuildInfo,
-		metricSplunkIndexerThroughput: newMetricSplunkIndexerThroughput(mbc.Metrics.SplunkIndexerThroughput),
-		metricSplunkLicenseIndexUsage: newMetricSplunkLicenseIndexUsage(mbc.Metrics.SplunkLicenseIndexUsage),
+		config:                                        mbc,
+		startTime:                                     pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                                 pmetric.NewMetrics(),
+		buildInfo:                                     settings.BuildInfo,
+		metricSplunkAlertsTriggeredCount:              newMetricSplunkAlertsTriggeredCount(mbc.Metrics.SplunkAlertsTriggeredCount),
+		metricSplunkAlertsTriggeredOldestSeconds:      newMetricSplunkAlertsTriggeredOldestSeconds(mbc.Metrics.SplunkAlertsTriggeredOldestSeconds),
+		metricSplunkAuthFailuresCount:                 newMetricSplunkAuthFailuresCount(mbc.Metrics.SplunkAuthFailuresCount),
+		metricSplunkClusterFixupPendingCount:          newMetricSplunkClusterFixupPendingCount(mbc.Metrics.SplunkClusterFixupPendingCount),
+		metricSplunkClusterIndexReplicatedCopies:      newMetricSplunkClusterIndexReplicatedCopies(mbc.Metrics.SplunkClusterIndexReplicatedCopies),
+		metricSplunkClusterIndexSearchable:            newMetricSplunkClusterIndexSearchable(mbc.Metrics.SplunkClusterIndexSearchable),
+		metricSplunkClusterPeerFixupTasks:             newMetricSplunkClusterPeerFixupTasks(mbc.Metrics.SplunkClusterPeerFixupTasks),
+		metricSplunkClusterPeerReplicationQueueLength: newMetricSplunkClusterPeerReplicationQueueLength(mbc.Metrics.SplunkClusterPeerReplicationQueueLength),
+		metricSplunkClusterPeerStatus:                 newMetricSplunkClusterPeerStatus(mbc.Metrics.SplunkClusterPeerStatus),
+		metricSplunkClusterReplicationFactor:          newMetricSplunkClusterReplicationFactor(mbc.Metrics.SplunkClusterReplicationFactor),
+		metricSplunkClusterReplicationFactorMet:       newMetricSplunkClusterReplicationFactorMet(mbc.Metrics.SplunkClusterReplicationFactorMet),
+		metricSplunkClusterSearchFactor:               newMetricSplunkClusterSearchFactor(mbc.Metrics.SplunkClusterSearchFactor),
+		metricSplunkClusterSearchFactorMet:            newMetricSplunkClusterSearchFactorMet(mbc.Metrics.SplunkClusterSearchFactorMet),
+		metricSplunkDatamodelAccelerationPercent:      newMetricSplunkDatamodelAccelerationPercent(mbc.Metrics.SplunkDatamodelAccelerationPercent),
+		metricSplunkDatamodelAccelerationSizeBytes:    newMetricSplunkDatamodelAccelerationSizeBytes(mbc.Metrics.SplunkDatamodelAccelerationSizeBytes),
+		metricSplunkDeploymentClientsCount:            newMetricSplunkDeploymentClientsCount(mbc.Metrics.SplunkDeploymentClientsCount),
+		metricSplunkDeploymentServerclassClients:      newMetricSplunkDeploymentServerclassClients(mbc.Metrics.SplunkDeploymentServerclassClients),
+		metricSplunkDispatchArtifactCount:             newMetricSplunkDispatchArtifactCount(mbc.Metrics.SplunkDispatchArtifactCount),
+		metricSplunkDispatchDiskUsedBytes:             newMetricSplunkDispatchDiskUsedBytes(mbc.Metrics.SplunkDispatchDiskUsedBytes),
+		metricSplunkForwarderConnectionsCount:         newMetricSplunkForwarderConnectionsCount(mbc.Metrics.SplunkForwarderConnectionsCount),
+		metricSplunkForwarderDataReceivedBytes:        newMetricSplunkForwarderDataReceivedBytes(mbc.Metrics.SplunkForwarderDataReceivedBytes),
+		metricSplunkHecDataReceivedBytes:              newMetricSplunkHecDataReceivedBytes(mbc.Metrics.SplunkHecDataReceivedBytes),
+		metricSplunkHecErrorsCount:                    newMetricSplunkHecErrorsCount(mbc.Metrics.SplunkHecErrorsCount),
+		metricSplunkHecRequestsCount:                  newMetricSplunkHecRequestsCount(mbc.Metrics.SplunkHecRequestsCount),
+		metricSplunkHecTokenEnabled:                   newMetricSplunkHecTokenEnabled(mbc.Metrics.SplunkHecTokenEnabled),
+		metricSplunkIndexBucketCount:                  newMetricSplunkIndexBucketCount(mbc.Metrics.SplunkIndexBucketCount),
+		metricSplunkIndexBucketsFrozenCount:           newMetricSplunkIndexBucketsFrozenCount(mbc.Metrics.SplunkIndexBucketsFrozenCount),
+		metricSplunkIndexBucketsRolledCount:           newMetricSplunkIndexBucketsRolledCount(mbc.Metrics.SplunkIndexBucketsRolledCount),
+		metricSplunkIndexEarliestEventSeconds:         newMetricSplunkIndexEarliestEventSeconds(mbc.Metrics.SplunkIndexEarliestEventSeconds),
+		metricSplunkIndexEventCount:                   newMetricSplunkIndexEventCount(mbc.Metrics.SplunkIndexEventCount),
+		metricSplunkIndexEventsSearchedRate:           newMetricSplunkIndexEventsSearchedRate(mbc.Metrics.SplunkIndexEventsSearchedRate),
+		metricSplunkIndexEventsWrittenRate:            newMetricSplunkIndexEventsWrittenRate(mbc.Metrics.SplunkIndexEventsWrittenRate),
+		metricSplunkIndexFrozenTimeSeconds:            newMetricSplunkIndexFrozenTimeSeconds(mbc.Metrics.SplunkIndexFrozenTimeSeconds),
+		metricSplunkIndexHotBucketsCount:              newMetricSplunkIndexHotBucketsCount(mbc.Metrics.SplunkIndexHotBucketsCount),
+		metricSplunkIndexHotBucketsMax:                newMetricSplunkIndexHotBucketsMax(mbc.Metrics.SplunkIndexHotBucketsMax),
+		metricSplunkIndexLatestEventSeconds:           newMetricSplunkIndexLatestEventSeconds(mbc.Metrics.SplunkIndexLatestEventSeconds),
+		metricSplunkIndexMaxSizeBytes:                 newMetricSplunkIndexMaxSizeBytes(mbc.Metrics.SplunkIndexMaxSizeBytes),
+		metricSplunkIndexRawSizeBytes:                 newMetricSplunkIndexRawSizeBytes(mbc.Metrics.SplunkIndexRawSizeBytes),
+		metricSplunkIndexSearchDurationSeconds:        newMetricSplunkIndexSearchDurationSeconds(mbc.Metrics.SplunkIndexSearchDurationSeconds),
+		metricSplunkIndexThawedSizeBytes:              newMetricSplunkIndexThawedSizeBytes(mbc.Metrics.SplunkIndexThawedSizeBytes),
+		metricSplunkIndexerIngestionLatencySeconds:    newMetricSplunkIndexerIngestionLatencySeconds(mbc.Metrics.SplunkIndexerIngestionLatencySeconds),
+		metricSplunkIndexerQueueLatencySeconds:        newMetricSplunkIndexerQueueLatencySeconds(mbc.Metrics.SplunkIndexerQueueLatencySeconds),
+		metricSplunkIndexerQueueRatio:                 newMetricSplunkIndexerQueueRatio(mbc.Metrics.SplunkIndexerQueueRatio),
+		metricSplunkIndexerThroughput:                 newMetricSplunkIndexerThroughput(mbc.Metrics.SplunkIndexerThroughput),
+		metricSplunkIndexerThroughputBySourcetype:     newMetricSplunkIndexerThroughputBySourcetype(mbc.Metrics.SplunkIndexerThroughputBySourcetype),
+		metricSplunkIndexesCount:                      newMetricSplunkIndexesCount(mbc.Metrics.SplunkIndexesCount),
+		metricSplunkKvstoreBackupRestoreStatus:        newMetricSplunkKvstoreBackupRestoreStatus(mbc.Metrics.SplunkKvstoreBackupRestoreStatus),
+		metricSplunkKvstoreCollectionCount:            newMetricSplunkKvstoreCollectionCount(mbc.Metrics.SplunkKvstoreCollectionCount),
+		metricSplunkKvstoreCollectionSizeBytes:        newMetricSplunkKvstoreCollectionSizeBytes(mbc.Metrics.SplunkKvstoreCollectionSizeBytes),
+		metricSplunkKvstoreReplicationStatus:          newMetricSplunkKvstoreReplicationStatus(mbc.Metrics.SplunkKvstoreReplicationStatus),
+		metricSplunkKvstoreStatus:                     newMetricSplunkKvstoreStatus(mbc.Metrics.SplunkKvstoreStatus),
+		metricSplunkLicenseIndexUsage:                 newMetricSplunkLicenseIndexUsage(mbc.Metrics.SplunkLicenseIndexUsage),
+		metricSplunkLicensePoolQuotaBytes:             newMetricSplunkLicensePoolQuotaBytes(mbc.Metrics.SplunkLicensePoolQuotaBytes),
+		metricSplunkLicensePoolUsedBytes:              newMetricSplunkLicensePoolUsedBytes(mbc.Metrics.SplunkLicensePoolUsedBytes),
+		metricSplunkLicenseSlaveCount:                 newMetricSplunkLicenseSlaveCount(mbc.Metrics.SplunkLicenseSlaveCount),
+		metricSplunkLicenseViolation:                  newMetricSplunkLicenseViolation(mbc.Metrics.SplunkLicenseViolation),
+		metricSplunkLicenseWarningCount:               newMetricSplunkLicenseWarningCount(mbc.Metrics.SplunkLicenseWarningCount),
+		metricSplunkPipelineCPUSeconds:                newMetricSplunkPipelineCPUSeconds(mbc.Metrics.SplunkPipelineCPUSeconds),
+		metricSplunkProcessCPUPercent:                 newMetricSplunkProcessCPUPercent(mbc.Metrics.SplunkProcessCPUPercent),
+		metricSplunkProcessMemoryBytes:                newMetricSplunkProcessMemoryBytes(mbc.Metrics.SplunkProcessMemoryBytes),
+		metricSplunkReceiverSearchWaitSeconds:         newMetricSplunkReceiverSearchWaitSeconds(mbc.Metrics.SplunkReceiverSearchWaitSeconds),
+		metricSplunkSavedsearchAlertFiredCount:        newMetricSplunkSavedsearchAlertFiredCount(mbc.Metrics.SplunkSavedsearchAlertFiredCount),
+		metricSplunkSavedsearchAlertSuppressedCount:   newMetricSplunkSavedsearchAlertSuppressedCount(mbc.Metrics.SplunkSavedsearchAlertSuppressedCount),
+		metricSplunkSchedulerExecutionDuration:        newMetricSplunkSchedulerExecutionDuration(mbc.Metrics.SplunkSchedulerExecutionDuration),
+		metricSplunkSchedulerLagSeconds:               newMetricSplunkSchedulerLagSeconds(mbc.Metrics.SplunkSchedulerLagSeconds),
+		metricSplunkSchedulerOldestQueuedSeconds:      newMetricSplunkSchedulerOldestQueuedSeconds(mbc.Metrics.SplunkSchedulerOldestQueuedSeconds),
+		metricSplunkSchedulerSkippedCount:             newMetricSplunkSchedulerSkippedCount(mbc.Metrics.SplunkSchedulerSkippedCount),
+		metricSplunkSearchCacheHitRatio:               newMetricSplunkSearchCacheHitRatio(mbc.Metrics.SplunkSearchCacheHitRatio),
+		metricSplunkSearchCount:                       newMetricSplunkSearchCount(mbc.Metrics.SplunkSearchCount),
+		metricSplunkSearchEventCount:                  newMetricSplunkSearchEventCount(mbc.Metrics.SplunkSearchEventCount),
+		metricSplunkSearchRunDurationSeconds:          newMetricSplunkSearchRunDurationSeconds(mbc.Metrics.SplunkSearchRunDurationSeconds),
+		metricSplunkSearchScanCount:                   newMetricSplunkSearchScanCount(mbc.Metrics.SplunkSearchScanCount),
+		metricSplunkSearchTimeoutCount:                newMetricSplunkSearchTimeoutCount(mbc.Metrics.SplunkSearchTimeoutCount),
+		metricSplunkSearchesLimit:                     newMetricSplunkSearchesLimit(mbc.Metrics.SplunkSearchesLimit),
+		metricSplunkSearchesQueuedCount:               newMetricSplunkSearchesQueuedCount(mbc.Metrics.SplunkSearchesQueuedCount),
+		metricSplunkSearchesRealtimeLimit:             newMetricSplunkSearchesRealtimeLimit(mbc.Metrics.SplunkSearchesRealtimeLimit),
+		metricSplunkSearchesRealtimeRunningCount:      newMetricSplunkSearchesRealtimeRunningCount(mbc.Metrics.SplunkSearchesRealtimeRunningCount),
+		metricSplunkSearchesRunningCount:              newMetricSplunkSearchesRunningCount(mbc.Metrics.SplunkSearchesRunningCount),
+		metricSplunkServerCPUUsagePercent:             newMetricSplunkServerCPUUsagePercent(mbc.Metrics.SplunkServerCPUUsagePercent),
+		metricSplunkServerMemoryUsageBytes:            newMetricSplunkServerMemoryUsageBytes(mbc.Metrics.SplunkServerMemoryUsageBytes),
+		metricSplunkServerPartitionCapacityBytes:      newMetricSplunkServerPartitionCapacityBytes(mbc.Metrics.SplunkServerPartitionCapacityBytes),
+		metricSplunkServerPartitionFreeBytes:          newMetricSplunkServerPartitionFreeBytes(mbc.Metrics.SplunkServerPartitionFreeBytes),
+		metricSplunkServerPartitionUsedBytes:          newMetricSplunkServerPartitionUsedBytes(mbc.Metrics.SplunkServerPartitionUsedBytes),
+		metricSplunkShcArtifactReplicationFailures:    newMetricSplunkShcArtifactReplicationFailures(mbc.Metrics.SplunkShcArtifactReplicationFailures),
+		metricSplunkShcCaptainElectionCount:           newMetricSplunkShcCaptainElectionCount(mbc.Metrics.SplunkShcCaptainElectionCount),
+		metricSplunkShcMemberStatus:                   newMetricSplunkShcMemberStatus(mbc.Metrics.SplunkShcMemberStatus),
+		metricSplunkShcReplicationPendingCount:        newMetricSplunkShcReplicationPendingCount(mbc.Metrics.SplunkShcReplicationPendingCount),
+		metricSplunkShcReplicationStatus:              newMetricSplunkShcReplicationStatus(mbc.Metrics.SplunkShcReplicationStatus),
+		metricSplunkSmartstoreCacheHitRatio:           newMetricSplunkSmartstoreCacheHitRatio(mbc.Metrics.SplunkSmartstoreCacheHitRatio),
+		metricSplunkSmartstoreEvictionCount:           newMetricSplunkSmartstoreEvictionCount(mbc.Metrics.SplunkSmartstoreEvictionCount),
+		metricSplunkSmartstoreUploadPendingCount:      newMetricSplunkSmartstoreUploadPendingCount(mbc.Metrics.SplunkSmartstoreUploadPendingCount),
+		metricSplunkSourcetypeEventCount:              newMetricSplunkSourcetypeEventCount(mbc.Metrics.SplunkSourcetypeEventCount),
+		metricSplunkUp:                                newMetricSplunkUp(mbc.Metrics.SplunkUp),
+		metricSplunkUserDispatchQuotaLimit:            newMetricSplunkUserDispatchQuotaLimit(mbc.Metrics.SplunkUserDispatchQuotaLimit),
+		metricSplunkUserDispatchQuotaUsed:             newMetricSplunkUserDispatchQuotaUsed(mbc.Metrics.SplunkUserDispatchQuotaUsed),
 	}
 	for _, op := range options {
 		op(mb)
@@ -150,6 +5047,11 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 	return mb
 }
 
+// NewResourceBuilder returns a new resource builder that should be used to build a resource associated with for the emitted metrics.
+func (mb *MetricsBuilder) NewResourceBuilder() *ResourceBuilder {
+	return NewResourceBuilder(mb.config.ResourceAttributes)
+}
+
 // updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
 func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
 	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
@@ -199,8 +5101,101 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/splunkenterprisereceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSplunkAlertsTriggeredCount.emit(ils.Metrics())
+	mb.metricSplunkAlertsTriggeredOldestSeconds.emit(ils.Metrics())
+	mb.metricSplunkAuthFailuresCount.emit(ils.Metrics())
+	mb.metricSplunkClusterFixupPendingCount.emit(ils.Metrics())
+	mb.metricSplunkClusterIndexReplicatedCopies.emit(ils.Metrics())
+	mb.metricSplunkClusterIndexSearchable.emit(ils.Metrics())
+	mb.metricSplunkClusterPeerFixupTasks.emit(ils.Metrics())
+	mb.metricSplunkClusterPeerReplicationQueueLength.emit(ils.Metrics())
+	mb.metricSplunkClusterPeerStatus.emit(ils.Metrics())
+	mb.metricSplunkClusterReplicationFactor.emit(ils.Metrics())
+	mb.metricSplunkClusterReplicationFactorMet.emit(ils.Metrics())
+	mb.metricSplunkClusterSearchFactor.emit(ils.Metrics())
+	mb.metricSplunkClusterSearchFactorMet.emit(ils.Metrics())
+	mb.metricSplunkDatamodelAccelerationPercent.emit(ils.Metrics())
+	mb.metricSplunkDatamodelAccelerationSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkDeploymentClientsCount.emit(ils.Metrics())
+	mb.metricSplunkDeploymentServerclassClients.emit(ils.Metrics())
+	mb.metricSplunkDispatchArtifactCount.emit(ils.Metrics())
+	mb.metricSplunkDispatchDiskUsedBytes.emit(ils.Metrics())
+	mb.metricSplunkForwarderConnectionsCount.emit(ils.Metrics())
+	mb.metricSplunkForwarderDataReceivedBytes.emit(ils.Metrics())
+	mb.metricSplunkHecDataReceivedBytes.emit(ils.Metrics())
+	mb.metricSplunkHecErrorsCount.emit(ils.Metrics())
+	mb.metricSplunkHecRequestsCount.emit(ils.Metrics())
+	mb.metricSplunkHecTokenEnabled.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketCount.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketsFrozenCount.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketsRolledCount.emit(ils.Metrics())
+	mb.metricSplunkIndexEarliestEventSeconds.emit(ils.Metrics())
+	mb.metricSplunkIndexEventCount.emit(ils.Metrics())
+	mb.metricSplunkIndexEventsSearchedRate.emit(ils.Metrics())
+	mb.metricSplunkIndexEventsWrittenRate.emit(ils.Metrics())
+	mb.metricSplunkIndexFrozenTimeSeconds.emit(ils.Metrics())
+	mb.metricSplunkIndexHotBucketsCount.emit(ils.Metrics())
+	mb.metricSplunkIndexHotBucketsMax.emit(ils.Metrics())
+	mb.metricSplunkIndexLatestEventSeconds.emit(ils.Metrics())
+	mb.metricSplunkIndexMaxSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkIndexRawSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkIndexSearchDurationSeconds.emit(ils.Metrics())
+	mb.metricSplunkIndexThawedSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkIndexerIngestionLatencySeconds.emit(ils.Metrics())
+	mb.metricSplunkIndexerQueueLatencySeconds.emit(ils.Metrics())
+	mb.metricSplunkIndexerQueueRatio.emit(ils.Metrics())
 	mb.metricSplunkIndexerThroughput.emit(ils.Metrics())
+	mb.metricSplunkIndexerThroughputBySourcetype.emit(ils.Metrics())
+	mb.metricSplunkIndexesCount.emit(ils.Metrics())
+	mb.metricSplunkKvstoreBackupRestoreStatus.emit(ils.Metrics())
+	mb.metricSplunkKvstoreCollectionCount.emit(ils.Metrics())
+	mb.metricSplunkKvstoreCollectionSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkKvstoreReplicationStatus.emit(ils.Metrics())
+	mb.metricSplunkKvstoreStatus.emit(ils.Metrics())
 	mb.metricSplunkLicenseIndexUsage.emit(ils.Metrics())
+	mb.metricSplunkLicensePoolQuotaBytes.emit(ils.Metrics())
+	mb.metricSplunkLicensePoolUsedBytes.emit(ils.Metrics())
+	mb.metricSplunkLicenseSlaveCount.emit(ils.Metrics())
+	mb.metricSplunkLicenseViolation.emit(ils.Metrics())
+	mb.metricSplunkLicenseWarningCount.emit(ils.Metrics())
+	mb.metricSplunkPipelineCPUSeconds.emit(ils.Metrics())
+	mb.metricSplunkProcessCPUPercent.emit(ils.Metrics())
+	mb.metricSplunkProcessMemoryBytes.emit(ils.Metrics())
+	mb.metricSplunkReceiverSearchWaitSeconds.emit(ils.Metrics())
+	mb.metricSplunkSavedsearchAlertFiredCount.emit(ils.Metrics())
+	mb.metricSplunkSavedsearchAlertSuppressedCount.emit(ils.Metrics())
+	mb.metricSplunkSchedulerExecutionDuration.emit(ils.Metrics())
+	mb.metricSplunkSchedulerLagSeconds.emit(ils.Metrics())
+	mb.metricSplunkSchedulerOldestQueuedSeconds.emit(ils.Metrics())
+	mb.metricSplunkSchedulerSkippedCount.emit(ils.Metrics())
+	mb.metricSplunkSearchCacheHitRatio.emit(ils.Metrics())
+	mb.metricSplunkSearchCount.emit(ils.Metrics())
+	mb.metricSplunkSearchEventCount.emit(ils.Metrics())
+	mb.metricSplunkSearchRunDurationSeconds.emit(ils.Metrics())
+	mb.metricSplunkSearchScanCount.emit(ils.Metrics())
+	mb.metricSplunkSearchTimeoutCount.emit(ils.Metrics())
+	mb.metricSplunkSearchesLimit.emit(ils.Metrics())
+	mb.metricSplunkSearchesQueuedCount.emit(ils.Metrics())
+	mb.metricSplunkSearchesRealtimeLimit.emit(ils.Metrics())
+	mb.metricSplunkSearchesRealtimeRunningCount.emit(ils.Metrics())
+	mb.metricSplunkSearchesRunningCount.emit(ils.Metrics())
+	mb.metricSplunkServerCPUUsagePercent.emit(ils.Metrics())
+	mb.metricSplunkServerMemoryUsageBytes.emit(ils.Metrics())
+	mb.metricSplunkServerPartitionCapacityBytes.emit(ils.Metrics())
+	mb.metricSplunkServerPartitionFreeBytes.emit(ils.Metrics())
+	mb.metricSplunkServerPartitionUsedBytes.emit(ils.Metrics())
+	mb.metricSplunkShcArtifactReplicationFailures.emit(ils.Metrics())
+	mb.metricSplunkShcCaptainElectionCount.emit(ils.Metrics())
+	mb.metricSplunkShcMemberStatus.emit(ils.Metrics())
+	mb.metricSplunkShcReplicationPendingCount.emit(ils.Metrics())
+	mb.metricSplunkShcReplicationStatus.emit(ils.Metrics())
+	mb.metricSplunkSmartstoreCacheHitRatio.emit(ils.Metrics())
+	mb.metricSplunkSmartstoreEvictionCount.emit(ils.Metrics())
+	mb.metricSplunkSmartstoreUploadPendingCount.emit(ils.Metrics())
+	mb.metricSplunkSourcetypeEventCount.emit(ils.Metrics())
+	mb.metricSplunkUp.emit(ils.Metrics())
+	mb.metricSplunkUserDispatchQuotaLimit.emit(ils.Metrics())
+	mb.metricSplunkUserDispatchQuotaUsed.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -221,16 +5216,481 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordSplunkAlertsTriggeredCountDataPoint adds a data point to splunk.alerts.triggered.count metric.
+func (mb *MetricsBuilder) RecordSplunkAlertsTriggeredCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkAlertsTriggeredCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkAlertsTriggeredOldestSecondsDataPoint adds a data point to splunk.alerts.triggered.oldest.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkAlertsTriggeredOldestSecondsDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSplunkAlertsTriggeredOldestSeconds.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkAuthFailuresCountDataPoint adds a data point to splunk.auth.failures.count metric.
+func (mb *MetricsBuilder) RecordSplunkAuthFailuresCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkAuthFailuresCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterFixupPendingCountDataPoint adds a data point to splunk.cluster.fixup.pending.count metric.
+func (mb *MetricsBuilder) RecordSplunkClusterFixupPendingCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkClusterFixupPendingCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkClusterIndexReplicatedCopiesDataPoint adds a data point to splunk.cluster.index.replicated.copies metric.
+func (mb *MetricsBuilder) RecordSplunkClusterIndexReplicatedCopiesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkClusterIndexReplicatedCopies.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkClusterIndexSearchableDataPoint adds a data point to splunk.cluster.index.searchable metric.
+func (mb *MetricsBuilder) RecordSplunkClusterIndexSearchableDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkClusterIndexSearchable.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkClusterPeerFixupTasksDataPoint adds a data point to splunk.cluster.peer.fixup.tasks metric.
+func (mb *MetricsBuilder) RecordSplunkClusterPeerFixupTasksDataPoint(ts pcommon.Timestamp, val int64, splunkClusterPeerGUIDAttributeValue string, splunkClusterPeerNameAttributeValue string) {
+	mb.metricSplunkClusterPeerFixupTasks.recordDataPoint(mb.startTime, ts, val, splunkClusterPeerGUIDAttributeValue, splunkClusterPeerNameAttributeValue)
+}
+
+// RecordSplunkClusterPeerReplicationQueueLengthDataPoint adds a data point to splunk.cluster.peer.replication.queue.length metric.
+func (mb *MetricsBuilder) RecordSplunkClusterPeerReplicationQueueLengthDataPoint(ts pcommon.Timestamp, val int64, splunkClusterPeerGUIDAttributeValue string, splunkClusterPeerNameAttributeValue string) {
+	mb.metricSplunkClusterPeerReplicationQueueLength.recordDataPoint(mb.startTime, ts, val, splunkClusterPeerGUIDAttributeValue, splunkClusterPeerNameAttributeValue)
+}
+
+// RecordSplunkClusterPeerStatusDataPoint adds a data point to splunk.cluster.peer.status metric.
+func (mb *MetricsBuilder) RecordSplunkClusterPeerStatusDataPoint(ts pcommon.Timestamp, val int64, splunkClusterPeerGUIDAttributeValue string, splunkClusterPeerNameAttributeValue string, splunkClusterPeerStatusValueAttributeValue string) {
+	mb.metricSplunkClusterPeerStatus.recordDataPoint(mb.startTime, ts, val, splunkClusterPeerGUIDAttributeValue, splunkClusterPeerNameAttributeValue, splunkClusterPeerStatusValueAttributeValue)
+}
+
+// RecordSplunkClusterReplicationFactorDataPoint adds a data point to splunk.cluster.replication.factor metric.
+func (mb *MetricsBuilder) RecordSplunkClusterReplicationFactorDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterReplicationFactor.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterReplicationFactorMetDataPoint adds a data point to splunk.cluster.replication.factor.met metric.
+func (mb *MetricsBuilder) RecordSplunkClusterReplicationFactorMetDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterReplicationFactorMet.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterSearchFactorDataPoint adds a data point to splunk.cluster.search.factor metric.
+func (mb *MetricsBuilder) RecordSplunkClusterSearchFactorDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterSearchFactor.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterSearchFactorMetDataPoint adds a data point to splunk.cluster.search.factor.met metric.
+func (mb *MetricsBuilder) RecordSplunkClusterSearchFactorMetDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterSearchFactorMet.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkDatamodelAccelerationPercentDataPoint adds a data point to splunk.datamodel.acceleration.percent metric.
+func (mb *MetricsBuilder) RecordSplunkDatamodelAccelerationPercentDataPoint(ts pcommon.Timestamp, val float64, splunkDatamodelNameAttributeValue string) {
+	mb.metricSplunkDatamodelAccelerationPercent.recordDataPoint(mb.startTime, ts, val, splunkDatamodelNameAttributeValue)
+}
+
+// RecordSplunkDatamodelAccelerationSizeBytesDataPoint adds a data point to splunk.datamodel.acceleration.size.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkDatamodelAccelerationSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkDatamodelNameAttributeValue string) {
+	mb.metricSplunkDatamodelAccelerationSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkDatamodelNameAttributeValue)
+}
+
+// RecordSplunkDeploymentClientsCountDataPoint adds a data point to splunk.deployment.clients.count metric.
+func (mb *MetricsBuilder) RecordSplunkDeploymentClientsCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkDeploymentClientsCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkDeploymentServerclassClientsDataPoint adds a data point to splunk.deployment.serverclass.clients metric.
+func (mb *MetricsBuilder) RecordSplunkDeploymentServerclassClientsDataPoint(ts pcommon.Timestamp, val int64, splunkServerclassNameAttributeValue string) {
+	mb.metricSplunkDeploymentServerclassClients.recordDataPoint(mb.startTime, ts, val, splunkServerclassNameAttributeValue)
+}
+
+// RecordSplunkDispatchArtifactCountDataPoint adds a data point to splunk.dispatch.artifact.count metric.
+func (mb *MetricsBuilder) RecordSplunkDispatchArtifactCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkDispatchArtifactCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkDispatchDiskUsedBytesDataPoint adds a data point to splunk.dispatch.disk.used.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkDispatchDiskUsedBytesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkDispatchDiskUsedBytes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkForwarderConnectionsCountDataPoint adds a data point to splunk.forwarder.connections.count metric.
+func (mb *MetricsBuilder) RecordSplunkForwarderConnectionsCountDataPoint(ts pcommon.Timestamp, val int64, splunkForwarderGUIDAttributeValue string) {
+	mb.metricSplunkForwarderConnectionsCount.recordDataPoint(mb.startTime, ts, val, splunkForwarderGUIDAttributeValue)
+}
+
+// RecordSplunkForwarderDataReceivedBytesDataPoint adds a data point to splunk.forwarder.data.received.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkForwarderDataReceivedBytesDataPoint(ts pcommon.Timestamp, val int64, splunkForwarderGUIDAttributeValue string) {
+	mb.metricSplunkForwarderDataReceivedBytes.recordDataPoint(mb.startTime, ts, val, splunkForwarderGUIDAttributeValue)
+}
+
+// RecordSplunkHecDataReceivedBytesDataPoint adds a data point to splunk.hec.data.received.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkHecDataReceivedBytesDataPoint(ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	mb.metricSplunkHecDataReceivedBytes.recordDataPoint(mb.startTime, ts, val, splunkHecTokenNameAttributeValue)
+}
+
+// RecordSplunkHecErrorsCountDataPoint adds a data point to splunk.hec.errors.count metric.
+func (mb *MetricsBuilder) RecordSplunkHecErrorsCountDataPoint(ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	mb.metricSplunkHecErrorsCount.recordDataPoint(mb.startTime, ts, val, splunkHecTokenNameAttributeValue)
+}
+
+// RecordSplunkHecRequestsCountDataPoint adds a data point to splunk.hec.requests.count metric.
+func (mb *MetricsBuilder) RecordSplunkHecRequestsCountDataPoint(ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	mb.metricSplunkHecRequestsCount.recordDataPoint(mb.startTime, ts, val, splunkHecTokenNameAttributeValue)
+}
+
+// RecordSplunkHecTokenEnabledDataPoint adds a data point to splunk.hec.token.enabled metric.
+func (mb *MetricsBuilder) RecordSplunkHecTokenEnabledDataPoint(ts pcommon.Timestamp, val int64, splunkHecTokenNameAttributeValue string) {
+	mb.metricSplunkHecTokenEnabled.recordDataPoint(mb.startTime, ts, val, splunkHecTokenNameAttributeValue)
+}
+
+// RecordSplunkIndexBucketCountDataPoint adds a data point to splunk.index.bucket.count metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexBucketsFrozenCountDataPoint adds a data point to splunk.index.buckets.frozen.count metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketsFrozenCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketsFrozenCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexBucketsRolledCountDataPoint adds a data point to splunk.index.buckets.rolled.count metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketsRolledCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketsRolledCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexEarliestEventSecondsDataPoint adds a data point to splunk.index.earliest.event.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkIndexEarliestEventSecondsDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexEarliestEventSeconds.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexEventCountDataPoint adds a data point to splunk.index.event.count metric.
+func (mb *MetricsBuilder) RecordSplunkIndexEventCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexEventCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexEventsSearchedRateDataPoint adds a data point to splunk.index.events.searched.rate metric.
+func (mb *MetricsBuilder) RecordSplunkIndexEventsSearchedRateDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexEventsSearchedRate.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexEventsWrittenRateDataPoint adds a data point to splunk.index.events.written.rate metric.
+func (mb *MetricsBuilder) RecordSplunkIndexEventsWrittenRateDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexEventsWrittenRate.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexFrozenTimeSecondsDataPoint adds a data point to splunk.index.frozen.time.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkIndexFrozenTimeSecondsDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexFrozenTimeSeconds.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexHotBucketsCountDataPoint adds a data point to splunk.index.hot.buckets.count metric.
+func (mb *MetricsBuilder) RecordSplunkIndexHotBucketsCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexHotBucketsCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexHotBucketsMaxDataPoint adds a data point to splunk.index.hot.buckets.max metric.
+func (mb *MetricsBuilder) RecordSplunkIndexHotBucketsMaxDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexHotBucketsMax.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexLatestEventSecondsDataPoint adds a data point to splunk.index.latest.event.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkIndexLatestEventSecondsDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexLatestEventSeconds.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexMaxSizeBytesDataPoint adds a data point to splunk.index.max.size.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexMaxSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexMaxSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexRawSizeBytesDataPoint adds a data point to splunk.index.raw.size.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexRawSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexRawSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexSearchDurationSecondsDataPoint adds a data point to splunk.index.search.duration.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkIndexSearchDurationSecondsDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexSearchDurationSeconds.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexThawedSizeBytesDataPoint adds a data point to splunk.index.thawed.size.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexThawedSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexThawedSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexerIngestionLatencySecondsDataPoint adds a data point to splunk.indexer.ingestion.latency.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerIngestionLatencySecondsDataPoint(ts pcommon.Timestamp, val float64, splunkSourcetypeNameAttributeValue string) {
+	mb.metricSplunkIndexerIngestionLatencySeconds.recordDataPoint(mb.startTime, ts, val, splunkSourcetypeNameAttributeValue)
+}
+
+// RecordSplunkIndexerQueueLatencySecondsDataPoint adds a data point to splunk.indexer.queue.latency.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerQueueLatencySecondsDataPoint(ts pcommon.Timestamp, val float64, splunkQueueNameAttributeValue string) {
+	mb.metricSplunkIndexerQueueLatencySeconds.recordDataPoint(mb.startTime, ts, val, splunkQueueNameAttributeValue)
+}
+
+// RecordSplunkIndexerQueueRatioDataPoint adds a data point to splunk.indexer.queue.ratio metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerQueueRatioDataPoint(ts pcommon.Timestamp, val float64, splunkQueueNameAttributeValue string) {
+	mb.metricSplunkIndexerQueueRatio.recordDataPoint(mb.startTime, ts, val, splunkQueueNameAttributeValue)
+}
+
 // RecordSplunkIndexerThroughputDataPoint adds a data point to splunk.indexer.throughput metric.
 func (mb *MetricsBuilder) RecordSplunkIndexerThroughputDataPoint(ts pcommon.Timestamp, val float64, splunkIndexerStatusAttributeValue string) {
 	mb.metricSplunkIndexerThroughput.recordDataPoint(mb.startTime, ts, val, splunkIndexerStatusAttributeValue)
 }
 
+// RecordSplunkIndexerThroughputBySourcetypeDataPoint adds a data point to splunk.indexer.throughput.by_sourcetype metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerThroughputBySourcetypeDataPoint(ts pcommon.Timestamp, val float64, splunkSourcetypeNameAttributeValue string) {
+	mb.metricSplunkIndexerThroughputBySourcetype.recordDataPoint(mb.startTime, ts, val, splunkSourcetypeNameAttributeValue)
+}
+
+// RecordSplunkIndexesCountDataPoint adds a data point to splunk.indexes.count metric.
+func (mb *MetricsBuilder) RecordSplunkIndexesCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexTypeAttributeValue string) {
+	mb.metricSplunkIndexesCount.recordDataPoint(mb.startTime, ts, val, splunkIndexTypeAttributeValue)
+}
+
+// RecordSplunkKvstoreBackupRestoreStatusDataPoint adds a data point to splunk.kvstore.backup.restore.status metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreBackupRestoreStatusDataPoint(ts pcommon.Timestamp, val int64, splunkKvstoreStatusValueAttributeValue string) {
+	mb.metricSplunkKvstoreBackupRestoreStatus.recordDataPoint(mb.startTime, ts, val, splunkKvstoreStatusValueAttributeValue)
+}
+
+// RecordSplunkKvstoreCollectionCountDataPoint adds a data point to splunk.kvstore.collection.count metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreCollectionCountDataPoint(ts pcommon.Timestamp, val int64, splunkKvstoreCollectionNameAttributeValue string, splunkAppNameAttributeValue string) {
+	mb.metricSplunkKvstoreCollectionCount.recordDataPoint(mb.startTime, ts, val, splunkKvstoreCollectionNameAttributeValue, splunkAppNameAttributeValue)
+}
+
+// RecordSplunkKvstoreCollectionSizeBytesDataPoint adds a data point to splunk.kvstore.collection.size.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreCollectionSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkKvstoreCollectionNameAttributeValue string, splunkAppNameAttributeValue string) {
+	mb.metricSplunkKvstoreCollectionSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkKvstoreCollectionNameAttributeValue, splunkAppNameAttributeValue)
+}
+
+// RecordSplunkKvstoreReplicationStatusDataPoint adds a data point to splunk.kvstore.replication.status metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreReplicationStatusDataPoint(ts pcommon.Timestamp, val int64, splunkKvstoreStatusValueAttributeValue string) {
+	mb.metricSplunkKvstoreReplicationStatus.recordDataPoint(mb.startTime, ts, val, splunkKvstoreStatusValueAttributeValue)
+}
+
+// RecordSplunkKvstoreStatusDataPoint adds a data point to splunk.kvstore.status metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreStatusDataPoint(ts pcommon.Timestamp, val int64, splunkKvstoreStatusValueAttributeValue string) {
+	mb.metricSplunkKvstoreStatus.recordDataPoint(mb.startTime, ts, val, splunkKvstoreStatusValueAttributeValue)
+}
+
 // RecordSplunkLicenseIndexUsageDataPoint adds a data point to splunk.license.index.usage metric.
 func (mb *MetricsBuilder) RecordSplunkLicenseIndexUsageDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
 	mb.metricSplunkLicenseIndexUsage.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
 }
 
+// RecordSplunkLicensePoolQuotaBytesDataPoint adds a data point to splunk.license.pool.quota.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkLicensePoolQuotaBytesDataPoint(ts pcommon.Timestamp, val int64, splunkLicensePoolNameAttributeValue string) {
+	mb.metricSplunkLicensePoolQuotaBytes.recordDataPoint(mb.startTime, ts, val, splunkLicensePoolNameAttributeValue)
+}
+
+// RecordSplunkLicensePoolUsedBytesDataPoint adds a data point to splunk.license.pool.used.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkLicensePoolUsedBytesDataPoint(ts pcommon.Timestamp, val int64, splunkLicensePoolNameAttributeValue string) {
+	mb.metricSplunkLicensePoolUsedBytes.recordDataPoint(mb.startTime, ts, val, splunkLicensePoolNameAttributeValue)
+}
+
+// RecordSplunkLicenseSlaveCountDataPoint adds a data point to splunk.license.slave.count metric.
+func (mb *MetricsBuilder) RecordSplunkLicenseSlaveCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkLicenseSlaveCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkLicenseViolationDataPoint adds a data point to splunk.license.violation metric.
+func (mb *MetricsBuilder) RecordSplunkLicenseViolationDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkLicenseViolation.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkLicenseWarningCountDataPoint adds a data point to splunk.license.warning.count metric.
+func (mb *MetricsBuilder) RecordSplunkLicenseWarningCountDataPoint(ts pcommon.Timestamp, val int64, splunkLicensePoolNameAttributeValue string) {
+	mb.metricSplunkLicenseWarningCount.recordDataPoint(mb.startTime, ts, val, splunkLicensePoolNameAttributeValue)
+}
+
+// RecordSplunkPipelineCPUSecondsDataPoint adds a data point to splunk.pipeline.cpu.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkPipelineCPUSecondsDataPoint(ts pcommon.Timestamp, val float64, splunkPipelineNameAttributeValue string, splunkPipelineProcessorNameAttributeValue string) {
+	mb.metricSplunkPipelineCPUSeconds.recordDataPoint(mb.startTime, ts, val, splunkPipelineNameAttributeValue, splunkPipelineProcessorNameAttributeValue)
+}
+
+// RecordSplunkProcessCPUPercentDataPoint adds a data point to splunk.process.cpu.percent metric.
+func (mb *MetricsBuilder) RecordSplunkProcessCPUPercentDataPoint(ts pcommon.Timestamp, val float64, splunkProcessNameAttributeValue string) {
+	mb.metricSplunkProcessCPUPercent.recordDataPoint(mb.startTime, ts, val, splunkProcessNameAttributeValue)
+}
+
+// RecordSplunkProcessMemoryBytesDataPoint adds a data point to splunk.process.memory.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkProcessMemoryBytesDataPoint(ts pcommon.Timestamp, val int64, splunkProcessNameAttributeValue string) {
+	mb.metricSplunkProcessMemoryBytes.recordDataPoint(mb.startTime, ts, val, splunkProcessNameAttributeValue)
+}
+
+// RecordSplunkReceiverSearchWaitSecondsDataPoint adds a data point to splunk.receiver.search.wait.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverSearchWaitSecondsDataPoint(ts pcommon.Timestamp, val float64, splunkSearchNameAttributeValue string) {
+	mb.metricSplunkReceiverSearchWaitSeconds.recordDataPoint(mb.startTime, ts, val, splunkSearchNameAttributeValue)
+}
+
+// RecordSplunkSavedsearchAlertFiredCountDataPoint adds a data point to splunk.savedsearch.alert.fired.count metric.
+func (mb *MetricsBuilder) RecordSplunkSavedsearchAlertFiredCountDataPoint(ts pcommon.Timestamp, val int64, splunkSavedsearchNameAttributeValue string) {
+	mb.metricSplunkSavedsearchAlertFiredCount.recordDataPoint(mb.startTime, ts, val, splunkSavedsearchNameAttributeValue)
+}
+
+// RecordSplunkSavedsearchAlertSuppressedCountDataPoint adds a data point to splunk.savedsearch.alert.suppressed.count metric.
+func (mb *MetricsBuilder) RecordSplunkSavedsearchAlertSuppressedCountDataPoint(ts pcommon.Timestamp, val int64, splunkSavedsearchNameAttributeValue string) {
+	mb.metricSplunkSavedsearchAlertSuppressedCount.recordDataPoint(mb.startTime, ts, val, splunkSavedsearchNameAttributeValue)
+}
+
+// RecordSplunkSchedulerExecutionDurationDataPoint adds a data point to splunk.scheduler.execution.duration metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerExecutionDurationDataPoint(ts pcommon.Timestamp, val float64, splunkSavedsearchNameAttributeValue string) {
+	mb.metricSplunkSchedulerExecutionDuration.recordDataPoint(mb.startTime, ts, val, splunkSavedsearchNameAttributeValue)
+}
+
+// RecordSplunkSchedulerLagSecondsDataPoint adds a data point to splunk.scheduler.lag.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerLagSecondsDataPoint(ts pcommon.Timestamp, val float64, splunkSavedsearchNameAttributeValue string) {
+	mb.metricSplunkSchedulerLagSeconds.recordDataPoint(mb.startTime, ts, val, splunkSavedsearchNameAttributeValue)
+}
+
+// RecordSplunkSchedulerOldestQueuedSecondsDataPoint adds a data point to splunk.scheduler.oldest.queued.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerOldestQueuedSecondsDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSplunkSchedulerOldestQueuedSeconds.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSchedulerSkippedCountDataPoint adds a data point to splunk.scheduler.skipped.count metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerSkippedCountDataPoint(ts pcommon.Timestamp, val int64, splunkSavedsearchNameAttributeValue string, splunkSchedulerSkipReasonAttributeValue string) {
+	mb.metricSplunkSchedulerSkippedCount.recordDataPoint(mb.startTime, ts, val, splunkSavedsearchNameAttributeValue, splunkSchedulerSkipReasonAttributeValue)
+}
+
+// RecordSplunkSearchCacheHitRatioDataPoint adds a data point to splunk.search.cache.hit.ratio metric.
+func (mb *MetricsBuilder) RecordSplunkSearchCacheHitRatioDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSplunkSearchCacheHitRatio.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSearchCountDataPoint adds a data point to splunk.search.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchCountDataPoint(ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string) {
+	mb.metricSplunkSearchCount.recordDataPoint(mb.startTime, ts, val, splunkAppNameAttributeValue)
+}
+
+// RecordSplunkSearchEventCountDataPoint adds a data point to splunk.search.event.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchEventCountDataPoint(ts pcommon.Timestamp, val int64, splunkSearchNameAttributeValue string) {
+	mb.metricSplunkSearchEventCount.recordDataPoint(mb.startTime, ts, val, splunkSearchNameAttributeValue)
+}
+
+// RecordSplunkSearchRunDurationSecondsDataPoint adds a data point to splunk.search.run.duration.seconds metric.
+func (mb *MetricsBuilder) RecordSplunkSearchRunDurationSecondsDataPoint(ts pcommon.Timestamp, val float64, splunkSearchNameAttributeValue string) {
+	mb.metricSplunkSearchRunDurationSeconds.recordDataPoint(mb.startTime, ts, val, splunkSearchNameAttributeValue)
+}
+
+// RecordSplunkSearchScanCountDataPoint adds a data point to splunk.search.scan.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchScanCountDataPoint(ts pcommon.Timestamp, val int64, splunkSearchNameAttributeValue string) {
+	mb.metricSplunkSearchScanCount.recordDataPoint(mb.startTime, ts, val, splunkSearchNameAttributeValue)
+}
+
+// RecordSplunkSearchTimeoutCountDataPoint adds a data point to splunk.search.timeout.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchTimeoutCountDataPoint(ts pcommon.Timestamp, val int64, splunkSearchNameAttributeValue string) {
+	mb.metricSplunkSearchTimeoutCount.recordDataPoint(mb.startTime, ts, val, splunkSearchNameAttributeValue)
+}
+
+// RecordSplunkSearchesLimitDataPoint adds a data point to splunk.searches.limit metric.
+func (mb *MetricsBuilder) RecordSplunkSearchesLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkSearchesLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSearchesQueuedCountDataPoint adds a data point to splunk.searches.queued.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchesQueuedCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkSearchesQueuedCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSearchesRealtimeLimitDataPoint adds a data point to splunk.searches.realtime.limit metric.
+func (mb *MetricsBuilder) RecordSplunkSearchesRealtimeLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkSearchesRealtimeLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSearchesRealtimeRunningCountDataPoint adds a data point to splunk.searches.realtime.running.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchesRealtimeRunningCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkSearchesRealtimeRunningCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSearchesRunningCountDataPoint adds a data point to splunk.searches.running.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchesRunningCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkSearchesRunningCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkServerCPUUsagePercentDataPoint adds a data point to splunk.server.cpu.usage.percent metric.
+func (mb *MetricsBuilder) RecordSplunkServerCPUUsagePercentDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSplunkServerCPUUsagePercent.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkServerMemoryUsageBytesDataPoint adds a data point to splunk.server.memory.usage.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkServerMemoryUsageBytesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkServerMemoryUsageBytes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkServerPartitionCapacityBytesDataPoint adds a data point to splunk.server.partition.capacity.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkServerPartitionCapacityBytesDataPoint(ts pcommon.Timestamp, val int64, splunkPartitionMountpointAttributeValue string) {
+	mb.metricSplunkServerPartitionCapacityBytes.recordDataPoint(mb.startTime, ts, val, splunkPartitionMountpointAttributeValue)
+}
+
+// RecordSplunkServerPartitionFreeBytesDataPoint adds a data point to splunk.server.partition.free.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkServerPartitionFreeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkPartitionMountpointAttributeValue string) {
+	mb.metricSplunkServerPartitionFreeBytes.recordDataPoint(mb.startTime, ts, val, splunkPartitionMountpointAttributeValue)
+}
+
+// RecordSplunkServerPartitionUsedBytesDataPoint adds a data point to splunk.server.partition.used.bytes metric.
+func (mb *MetricsBuilder) RecordSplunkServerPartitionUsedBytesDataPoint(ts pcommon.Timestamp, val int64, splunkPartitionMountpointAttributeValue string) {
+	mb.metricSplunkServerPartitionUsedBytes.recordDataPoint(mb.startTime, ts, val, splunkPartitionMountpointAttributeValue)
+}
+
+// RecordSplunkShcArtifactReplicationFailuresDataPoint adds a data point to splunk.shc.artifact.replication.failures metric.
+func (mb *MetricsBuilder) RecordSplunkShcArtifactReplicationFailuresDataPoint(ts pcommon.Timestamp, val int64, splunkShcPeerGUIDAttributeValue string) {
+	mb.metricSplunkShcArtifactReplicationFailures.recordDataPoint(mb.startTime, ts, val, splunkShcPeerGUIDAttributeValue)
+}
+
+// RecordSplunkShcCaptainElectionCountDataPoint adds a data point to splunk.shc.captain.election.count metric.
+func (mb *MetricsBuilder) RecordSplunkShcCaptainElectionCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkShcCaptainElectionCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkShcMemberStatusDataPoint adds a data point to splunk.shc.member.status metric.
+func (mb *MetricsBuilder) RecordSplunkShcMemberStatusDataPoint(ts pcommon.Timestamp, val int64, splunkShcMemberStatusValueAttributeValue string) {
+	mb.metricSplunkShcMemberStatus.recordDataPoint(mb.startTime, ts, val, splunkShcMemberStatusValueAttributeValue)
+}
+
+// RecordSplunkShcReplicationPendingCountDataPoint adds a data point to splunk.shc.replication.pending.count metric.
+func (mb *MetricsBuilder) RecordSplunkShcReplicationPendingCountDataPoint(ts pcommon.Timestamp, val int64, splunkShcPeerGUIDAttributeValue string) {
+	mb.metricSplunkShcReplicationPendingCount.recordDataPoint(mb.startTime, ts, val, splunkShcPeerGUIDAttributeValue)
+}
+
+// RecordSplunkShcReplicationStatusDataPoint adds a data point to splunk.shc.replication.status metric.
+func (mb *MetricsBuilder) RecordSplunkShcReplicationStatusDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkShcReplicationStatus.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSmartstoreCacheHitRatioDataPoint adds a data point to splunk.smartstore.cache.hit.ratio metric.
+func (mb *MetricsBuilder) RecordSplunkSmartstoreCacheHitRatioDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkSmartstoreCacheHitRatio.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkSmartstoreEvictionCountDataPoint adds a data point to splunk.smartstore.eviction.count metric.
+func (mb *MetricsBuilder) RecordSplunkSmartstoreEvictionCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkSmartstoreEvictionCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkSmartstoreUploadPendingCountDataPoint adds a data point to splunk.smartstore.upload.pending.count metric.
+func (mb *MetricsBuilder) RecordSplunkSmartstoreUploadPendingCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkSmartstoreUploadPendingCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkSourcetypeEventCountDataPoint adds a data point to splunk.sourcetype.event.count metric.
+func (mb *MetricsBuilder) RecordSplunkSourcetypeEventCountDataPoint(ts pcommon.Timestamp, val int64, splunkSourcetypeNameAttributeValue string) {
+	mb.metricSplunkSourcetypeEventCount.recordDataPoint(mb.startTime, ts, val, splunkSourcetypeNameAttributeValue)
+}
+
+// RecordSplunkUpDataPoint adds a data point to splunk.up metric.
+func (mb *MetricsBuilder) RecordSplunkUpDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkUp.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkUserDispatchQuotaLimitDataPoint adds a data point to splunk.user.dispatch.quota.limit metric.
+func (mb *MetricsBuilder) RecordSplunkUserDispatchQuotaLimitDataPoint(ts pcommon.Timestamp, val int64, splunkUserNameAttributeValue string) {
+	mb.metricSplunkUserDispatchQuotaLimit.recordDataPoint(mb.startTime, ts, val, splunkUserNameAttributeValue)
+}
+
+// RecordSplunkUserDispatchQuotaUsedDataPoint adds a data point to splunk.user.dispatch.quota.used metric.
+func (mb *MetricsBuilder) RecordSplunkUserDispatchQuotaUsedDataPoint(ts pcommon.Timestamp, val int64, splunkUserNameAttributeValue string) {
+	mb.metricSplunkUserDispatchQuotaUsed.recordDataPoint(mb.startTime, ts, val, splunkUserNameAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -54,15 +54,301 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordSplunkAlertsTriggeredCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkAlertsTriggeredOldestSecondsDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkAuthFailuresCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterFixupPendingCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterIndexReplicatedCopiesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterIndexSearchableDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterPeerFixupTasksDataPoint(ts, 1, "splunk.cluster.peer.guid-val", "splunk.cluster.peer.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterPeerReplicationQueueLengthDataPoint(ts, 1, "splunk.cluster.peer.guid-val", "splunk.cluster.peer.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterPeerStatusDataPoint(ts, 1, "splunk.cluster.peer.guid-val", "splunk.cluster.peer.name-val", "splunk.cluster.peer.status.value-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterReplicationFactorDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterReplicationFactorMetDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterSearchFactorDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterSearchFactorMetDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkDatamodelAccelerationPercentDataPoint(ts, 1, "splunk.datamodel.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkDatamodelAccelerationSizeBytesDataPoint(ts, 1, "splunk.datamodel.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkDeploymentClientsCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkDeploymentServerclassClientsDataPoint(ts, 1, "splunk.serverclass.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkDispatchArtifactCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkDispatchDiskUsedBytesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkForwarderConnectionsCountDataPoint(ts, 1, "splunk.forwarder.guid-val")
+
+			allMetricsCount++
+			mb.RecordSplunkForwarderDataReceivedBytesDataPoint(ts, 1, "splunk.forwarder.guid-val")
+
+			allMetricsCount++
+			mb.RecordSplunkHecDataReceivedBytesDataPoint(ts, 1, "splunk.hec.token.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkHecErrorsCountDataPoint(ts, 1, "splunk.hec.token.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkHecRequestsCountDataPoint(ts, 1, "splunk.hec.token.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkHecTokenEnabledDataPoint(ts, 1, "splunk.hec.token.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketsFrozenCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketsRolledCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexEarliestEventSecondsDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexEventCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexEventsSearchedRateDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexEventsWrittenRateDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexFrozenTimeSecondsDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexHotBucketsCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexHotBucketsMaxDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexLatestEventSecondsDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexMaxSizeBytesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexRawSizeBytesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexSearchDurationSecondsDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexThawedSizeBytesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexerIngestionLatencySecondsDataPoint(ts, 1, "splunk.sourcetype.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexerQueueLatencySecondsDataPoint(ts, 1, "splunk.queue.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexerQueueRatioDataPoint(ts, 1, "splunk.queue.name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkIndexerThroughputDataPoint(ts, 1, "splunk.indexer.status-val")
 
+			allMetricsCount++
+			mb.RecordSplunkIndexerThroughputBySourcetypeDataPoint(ts, 1, "splunk.sourcetype.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexesCountDataPoint(ts, 1, "splunk.index.type-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreBackupRestoreStatusDataPoint(ts, 1, "splunk.kvstore.status.value-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreCollectionCountDataPoint(ts, 1, "splunk.kvstore.collection.name-val", "splunk.app.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreCollectionSizeBytesDataPoint(ts, 1, "splunk.kvstore.collection.name-val", "splunk.app.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreReplicationStatusDataPoint(ts, 1, "splunk.kvstore.status.value-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreStatusDataPoint(ts, 1, "splunk.kvstore.status.value-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkLicenseIndexUsageDataPoint(ts, 1, "splunk.index.name-val")
 
-			res := pcommon.NewResource()
+			allMetricsCount++
+			mb.RecordSplunkLicensePoolQuotaBytesDataPoint(ts, 1, "splunk.license.pool.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkLicensePoolUsedBytesDataPoint(ts, 1, "splunk.license.pool.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkLicenseSlaveCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkLicenseViolationDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkLicenseWarningCountDataPoint(ts, 1, "splunk.license.pool.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkPipelineCPUSecondsDataPoint(ts, 1, "splunk.pipeline.name-val", "splunk.pipeline.processor.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkProcessCPUPercentDataPoint(ts, 1, "splunk.process.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkProcessMemoryBytesDataPoint(ts, 1, "splunk.process.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverSearchWaitSecondsDataPoint(ts, 1, "splunk.search.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSavedsearchAlertFiredCountDataPoint(ts, 1, "splunk.savedsearch.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSavedsearchAlertSuppressedCountDataPoint(ts, 1, "splunk.savedsearch.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSchedulerExecutionDurationDataPoint(ts, 1, "splunk.savedsearch.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSchedulerLagSecondsDataPoint(ts, 1, "splunk.savedsearch.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSchedulerOldestQueuedSecondsDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSchedulerSkippedCountDataPoint(ts, 1, "splunk.savedsearch.name-val", "splunk.scheduler.skip.reason-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSearchCacheHitRatioDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSearchCountDataPoint(ts, 1, "splunk.app.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSearchEventCountDataPoint(ts, 1, "splunk.search.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSearchRunDurationSecondsDataPoint(ts, 1, "splunk.search.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSearchScanCountDataPoint(ts, 1, "splunk.search.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSearchTimeoutCountDataPoint(ts, 1, "splunk.search.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSearchesLimitDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSearchesQueuedCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSearchesRealtimeLimitDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSearchesRealtimeRunningCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSearchesRunningCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkServerCPUUsagePercentDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkServerMemoryUsageBytesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkServerPartitionCapacityBytesDataPoint(ts, 1, "splunk.partition.mountpoint-val")
+
+			allMetricsCount++
+			mb.RecordSplunkServerPartitionFreeBytesDataPoint(ts, 1, "splunk.partition.mountpoint-val")
+
+			allMetricsCount++
+			mb.RecordSplunkServerPartitionUsedBytesDataPoint(ts, 1, "splunk.partition.mountpoint-val")
+
+			allMetricsCount++
+			mb.RecordSplunkShcArtifactReplicationFailuresDataPoint(ts, 1, "splunk.shc.peer.guid-val")
+
+			allMetricsCount++
+			mb.RecordSplunkShcCaptainElectionCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkShcMemberStatusDataPoint(ts, 1, "splunk.shc.member.status.value-val")
+
+			allMetricsCount++
+			mb.RecordSplunkShcReplicationPendingCountDataPoint(ts, 1, "splunk.shc.peer.guid-val")
+
+			allMetricsCount++
+			mb.RecordSplunkShcReplicationStatusDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSmartstoreCacheHitRatioDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSmartstoreEvictionCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSmartstoreUploadPendingCountDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSourcetypeEventCountDataPoint(ts, 1, "splunk.sourcetype.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSplunkUpDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkUserDispatchQuotaLimitDataPoint(ts, 1, "splunk.user.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkUserDispatchQuotaUsedDataPoint(ts, 1, "splunk.user.name-val")
+
+			rb := mb.NewResourceBuilder()
+			rb.SetSplunkInstance("splunk.instance-val")
+			rb.SetSplunkServerGUID("splunk.server.guid-val")
+			rb.SetSplunkServerName("splunk.server.name-val")
+			rb.SetSplunkShcMemberGUID("splunk.shc.member.guid-val")
+			rb.SetSplunkVersion("splunk.version-val")
+			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 
 			if test.configSet == testSetNone {
@@ -84,28 +370,81 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
-				case "splunk.indexer.throughput":
-					assert.False(t, validatedMetrics["splunk.indexer.throughput"], "Found a duplicate in the metrics slice: splunk.indexer.throughput")
-					validatedMetrics["splunk.indexer.throughput"] = true
+				case "splunk.alerts.triggered.count":
+					assert.False(t, validatedMetrics["splunk.alerts.triggered.count"], "Found a duplicate in the metrics slice: splunk.alerts.triggered.count")
+					validatedMetrics["splunk.alerts.triggered.count"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking average bytes per second throughput of indexer", ms.At(i).Description())
-					assert.Equal(t, "By/s", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the number of triggered alerts Splunk retains, a sudden rise points to an alert storm", ms.At(i).Description())
+					assert.Equal(t, "{alerts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.alerts.triggered.oldest.seconds":
+					assert.False(t, validatedMetrics["splunk.alerts.triggered.oldest.seconds"], "Found a duplicate in the metrics slice: splunk.alerts.triggered.oldest.seconds")
+					validatedMetrics["splunk.alerts.triggered.oldest.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how long ago the oldest triggered alert Splunk retains was triggered, 0 when none is retained", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.Equal(t, float64(1), dp.DoubleValue())
-					attrVal, ok := dp.Attributes().Get("splunk.indexer.status")
+				case "splunk.auth.failures.count":
+					assert.False(t, validatedMetrics["splunk.auth.failures.count"], "Found a duplicate in the metrics slice: splunk.auth.failures.count")
+					validatedMetrics["splunk.auth.failures.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of logins to '/services/auth/login' the instance rejected since the receiver started. Only reported when session keys are used", ms.At(i).Description())
+					assert.Equal(t, "{failures}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.cluster.fixup.pending.count":
+					assert.False(t, validatedMetrics["splunk.cluster.fixup.pending.count"], "Found a duplicate in the metrics slice: splunk.cluster.fixup.pending.count")
+					validatedMetrics["splunk.cluster.fixup.pending.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of bucket copies of an index the cluster still has to replicate to meet its replication factor", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.indexer.status-val", attrVal.Str())
-				case "splunk.license.index.usage":
-					assert.False(t, validatedMetrics["splunk.license.index.usage"], "Found a duplicate in the metrics slice: splunk.license.index.usage")
-					validatedMetrics["splunk.license.index.usage"] = true
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.cluster.index.replicated.copies":
+					assert.False(t, validatedMetrics["splunk.cluster.index.replicated.copies"], "Found a duplicate in the metrics slice: splunk.cluster.index.replicated.copies")
+					validatedMetrics["splunk.cluster.index.replicated.copies"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the indexed license usage per index", ms.At(i).Description())
-					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the number of complete copies of an index's buckets held across the indexer cluster", ms.At(i).Description())
+					assert.Equal(t, "{copies}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.cluster.index.searchable":
+					assert.False(t, validatedMetrics["splunk.cluster.index.searchable"], "Found a duplicate in the metrics slice: splunk.cluster.index.searchable")
+					validatedMetrics["splunk.cluster.index.searchable"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking whether an index is fully searchable across the indexer cluster, 1 when it is and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
@@ -114,6 +453,1308 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("splunk.index.name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.cluster.peer.fixup.tasks":
+					assert.False(t, validatedMetrics["splunk.cluster.peer.fixup.tasks"], "Found a duplicate in the metrics slice: splunk.cluster.peer.fixup.tasks")
+					validatedMetrics["splunk.cluster.peer.fixup.tasks"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of fixup jobs the cluster manager has pending for a peer, the peers with the most hold up the recovery of the cluster", ms.At(i).Description())
+					assert.Equal(t, "{tasks}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.cluster.peer.guid")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.cluster.peer.guid-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.cluster.peer.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.cluster.peer.name-val", attrVal.Str())
+				case "splunk.cluster.peer.replication.queue.length":
+					assert.False(t, validatedMetrics["splunk.cluster.peer.replication.queue.length"], "Found a duplicate in the metrics slice: splunk.cluster.peer.replication.queue.length")
+					validatedMetrics["splunk.cluster.peer.replication.queue.length"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of bucket replications a peer of the indexer cluster takes part in as source or target, a peer that keeps a long queue is holding back replication", ms.At(i).Description())
+					assert.Equal(t, "{replications}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.cluster.peer.guid")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.cluster.peer.guid-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.cluster.peer.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.cluster.peer.name-val", attrVal.Str())
+				case "splunk.cluster.peer.status":
+					assert.False(t, validatedMetrics["splunk.cluster.peer.status"], "Found a duplicate in the metrics slice: splunk.cluster.peer.status")
+					validatedMetrics["splunk.cluster.peer.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the health of an indexer cluster peer, 1 when it is up and searchable and 0 otherwise, including while an up peer is pending becoming searchable", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.cluster.peer.guid")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.cluster.peer.guid-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.cluster.peer.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.cluster.peer.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.cluster.peer.status.value")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.cluster.peer.status.value-val", attrVal.Str())
+				case "splunk.cluster.replication.factor":
+					assert.False(t, validatedMetrics["splunk.cluster.replication.factor"], "Found a duplicate in the metrics slice: splunk.cluster.replication.factor")
+					validatedMetrics["splunk.cluster.replication.factor"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of copies of every bucket the indexer cluster is configured to keep", ms.At(i).Description())
+					assert.Equal(t, "{copies}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.cluster.replication.factor.met":
+					assert.False(t, validatedMetrics["splunk.cluster.replication.factor.met"], "Found a duplicate in the metrics slice: splunk.cluster.replication.factor.met")
+					validatedMetrics["splunk.cluster.replication.factor.met"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking whether the indexer cluster meets its replication factor, 1 when it does and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.cluster.search.factor":
+					assert.False(t, validatedMetrics["splunk.cluster.search.factor"], "Found a duplicate in the metrics slice: splunk.cluster.search.factor")
+					validatedMetrics["splunk.cluster.search.factor"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of searchable copies of every bucket the indexer cluster is configured to keep", ms.At(i).Description())
+					assert.Equal(t, "{copies}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.cluster.search.factor.met":
+					assert.False(t, validatedMetrics["splunk.cluster.search.factor.met"], "Found a duplicate in the metrics slice: splunk.cluster.search.factor.met")
+					validatedMetrics["splunk.cluster.search.factor.met"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking whether the indexer cluster meets its search factor, 1 when it does and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.datamodel.acceleration.percent":
+					assert.False(t, validatedMetrics["splunk.datamodel.acceleration.percent"], "Found a duplicate in the metrics slice: splunk.datamodel.acceleration.percent")
+					validatedMetrics["splunk.datamodel.acceleration.percent"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how much of the time range of an accelerated data model its summary covers", ms.At(i).Description())
+					assert.Equal(t, "%", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.datamodel.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.datamodel.name-val", attrVal.Str())
+				case "splunk.datamodel.acceleration.size.bytes":
+					assert.False(t, validatedMetrics["splunk.datamodel.acceleration.size.bytes"], "Found a duplicate in the metrics slice: splunk.datamodel.acceleration.size.bytes")
+					validatedMetrics["splunk.datamodel.acceleration.size.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the disk space used by the acceleration summary of a data model", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.datamodel.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.datamodel.name-val", attrVal.Str())
+				case "splunk.deployment.clients.count":
+					assert.False(t, validatedMetrics["splunk.deployment.clients.count"], "Found a duplicate in the metrics slice: splunk.deployment.clients.count")
+					validatedMetrics["splunk.deployment.clients.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of deployment clients that phoned home to this deployment server", ms.At(i).Description())
+					assert.Equal(t, "{clients}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.deployment.serverclass.clients":
+					assert.False(t, validatedMetrics["splunk.deployment.serverclass.clients"], "Found a duplicate in the metrics slice: splunk.deployment.serverclass.clients")
+					validatedMetrics["splunk.deployment.serverclass.clients"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of deployment clients that are members of a server class", ms.At(i).Description())
+					assert.Equal(t, "{clients}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.serverclass.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.serverclass.name-val", attrVal.Str())
+				case "splunk.dispatch.artifact.count":
+					assert.False(t, validatedMetrics["splunk.dispatch.artifact.count"], "Found a duplicate in the metrics slice: splunk.dispatch.artifact.count")
+					validatedMetrics["splunk.dispatch.artifact.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of search artifacts held in the dispatch directory", ms.At(i).Description())
+					assert.Equal(t, "{artifacts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.dispatch.disk.used.bytes":
+					assert.False(t, validatedMetrics["splunk.dispatch.disk.used.bytes"], "Found a duplicate in the metrics slice: splunk.dispatch.disk.used.bytes")
+					validatedMetrics["splunk.dispatch.disk.used.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the disk space used by the search artifacts held in the dispatch directory", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.forwarder.connections.count":
+					assert.False(t, validatedMetrics["splunk.forwarder.connections.count"], "Found a duplicate in the metrics slice: splunk.forwarder.connections.count")
+					validatedMetrics["splunk.forwarder.connections.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of connections a forwarder had open to the indexer over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.forwarder.guid")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.forwarder.guid-val", attrVal.Str())
+				case "splunk.forwarder.data.received.bytes":
+					assert.False(t, validatedMetrics["splunk.forwarder.data.received.bytes"], "Found a duplicate in the metrics slice: splunk.forwarder.data.received.bytes")
+					validatedMetrics["splunk.forwarder.data.received.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the bytes the indexer received from a forwarder over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.forwarder.guid")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.forwarder.guid-val", attrVal.Str())
+				case "splunk.hec.data.received.bytes":
+					assert.False(t, validatedMetrics["splunk.hec.data.received.bytes"], "Found a duplicate in the metrics slice: splunk.hec.data.received.bytes")
+					validatedMetrics["splunk.hec.data.received.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the bytes received by the HTTP Event Collector per token over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.hec.token.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.hec.token.name-val", attrVal.Str())
+				case "splunk.hec.errors.count":
+					assert.False(t, validatedMetrics["splunk.hec.errors.count"], "Found a duplicate in the metrics slice: splunk.hec.errors.count")
+					validatedMetrics["splunk.hec.errors.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of requests the HTTP Event Collector failed per token over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "{errors}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.hec.token.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.hec.token.name-val", attrVal.Str())
+				case "splunk.hec.requests.count":
+					assert.False(t, validatedMetrics["splunk.hec.requests.count"], "Found a duplicate in the metrics slice: splunk.hec.requests.count")
+					validatedMetrics["splunk.hec.requests.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of requests received by the HTTP Event Collector per token over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "{requests}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.hec.token.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.hec.token.name-val", attrVal.Str())
+				case "splunk.hec.token.enabled":
+					assert.False(t, validatedMetrics["splunk.hec.token.enabled"], "Found a duplicate in the metrics slice: splunk.hec.token.enabled")
+					validatedMetrics["splunk.hec.token.enabled"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking whether an HTTP Event Collector token accepts data, 1 when it is enabled and 0 when it was disabled", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.hec.token.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.hec.token.name-val", attrVal.Str())
+				case "splunk.index.bucket.count":
+					assert.False(t, validatedMetrics["splunk.index.bucket.count"], "Found a duplicate in the metrics slice: splunk.index.bucket.count")
+					validatedMetrics["splunk.index.bucket.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of buckets held by an index", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.buckets.frozen.count":
+					assert.False(t, validatedMetrics["splunk.index.buckets.frozen.count"], "Found a duplicate in the metrics slice: splunk.index.buckets.frozen.count")
+					validatedMetrics["splunk.index.buckets.frozen.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of buckets of an index rolled to frozen over the last 10 minutes, or since the previous scrape when estimated from the API", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.buckets.rolled.count":
+					assert.False(t, validatedMetrics["splunk.index.buckets.rolled.count"], "Found a duplicate in the metrics slice: splunk.index.buckets.rolled.count")
+					validatedMetrics["splunk.index.buckets.rolled.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of buckets of an index rolled from hot to warm over the last 10 minutes, or since the previous scrape when estimated from the API", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.earliest.event.seconds":
+					assert.False(t, validatedMetrics["splunk.index.earliest.event.seconds"], "Found a duplicate in the metrics slice: splunk.index.earliest.event.seconds")
+					validatedMetrics["splunk.index.earliest.event.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the time of the earliest event held by an index, in seconds since the epoch", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.event.count":
+					assert.False(t, validatedMetrics["splunk.index.event.count"], "Found a duplicate in the metrics slice: splunk.index.event.count")
+					validatedMetrics["splunk.index.event.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events held by an index", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.events.searched.rate":
+					assert.False(t, validatedMetrics["splunk.index.events.searched.rate"], "Found a duplicate in the metrics slice: splunk.index.events.searched.rate")
+					validatedMetrics["splunk.index.events.searched.rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events of an index scanned by searches per second, averaged over the last 10 minutes. Searches naming several indexes count their events against each of them", ms.At(i).Description())
+					assert.Equal(t, "{events}/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.events.written.rate":
+					assert.False(t, validatedMetrics["splunk.index.events.written.rate"], "Found a duplicate in the metrics slice: splunk.index.events.written.rate")
+					validatedMetrics["splunk.index.events.written.rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events written to an index per second, averaged over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "{events}/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.frozen.time.seconds":
+					assert.False(t, validatedMetrics["splunk.index.frozen.time.seconds"], "Found a duplicate in the metrics slice: splunk.index.frozen.time.seconds")
+					validatedMetrics["splunk.index.frozen.time.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the age past which the events of an index are frozen, its frozenTimePeriodInSecs setting", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.hot.buckets.count":
+					assert.False(t, validatedMetrics["splunk.index.hot.buckets.count"], "Found a duplicate in the metrics slice: splunk.index.hot.buckets.count")
+					validatedMetrics["splunk.index.hot.buckets.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of hot buckets an index has open. Indexing into the index stalls once it reaches splunk.index.hot.buckets.max", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.hot.buckets.max":
+					assert.False(t, validatedMetrics["splunk.index.hot.buckets.max"], "Found a duplicate in the metrics slice: splunk.index.hot.buckets.max")
+					validatedMetrics["splunk.index.hot.buckets.max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the maximum number of hot buckets an index is configured to have open, its maxHotBuckets setting", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.latest.event.seconds":
+					assert.False(t, validatedMetrics["splunk.index.latest.event.seconds"], "Found a duplicate in the metrics slice: splunk.index.latest.event.seconds")
+					validatedMetrics["splunk.index.latest.event.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the time of the latest event held by an index, in seconds since the epoch. Useful to tell how fresh the data of an index is", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.max.size.bytes":
+					assert.False(t, validatedMetrics["splunk.index.max.size.bytes"], "Found a duplicate in the metrics slice: splunk.index.max.size.bytes")
+					validatedMetrics["splunk.index.max.size.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size an index can grow to before its oldest buckets are frozen, its maxTotalDataSizeMB setting", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.raw.size.bytes":
+					assert.False(t, validatedMetrics["splunk.index.raw.size.bytes"], "Found a duplicate in the metrics slice: splunk.index.raw.size.bytes")
+					validatedMetrics["splunk.index.raw.size.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size of the raw data held by an index before compression", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.search.duration.seconds":
+					assert.False(t, validatedMetrics["splunk.index.search.duration.seconds"], "Found a duplicate in the metrics slice: splunk.index.search.duration.seconds")
+					validatedMetrics["splunk.index.search.duration.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average run time of the searches completed over the search time range that touched an index. Searches naming several indexes count against each of them", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.thawed.size.bytes":
+					assert.False(t, validatedMetrics["splunk.index.thawed.size.bytes"], "Found a duplicate in the metrics slice: splunk.index.thawed.size.bytes")
+					validatedMetrics["splunk.index.thawed.size.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the disk space used by the buckets restored into the thawed path of an index", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexer.ingestion.latency.seconds":
+					assert.False(t, validatedMetrics["splunk.indexer.ingestion.latency.seconds"], "Found a duplicate in the metrics slice: splunk.indexer.ingestion.latency.seconds")
+					validatedMetrics["splunk.indexer.ingestion.latency.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the delay between the time of the events of a source type indexed over the last 10 minutes and the time they were indexed, aggregated by ingestion_latency_statistic, avg by default", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.sourcetype.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.sourcetype.name-val", attrVal.Str())
+				case "splunk.indexer.queue.latency.seconds":
+					assert.False(t, validatedMetrics["splunk.indexer.queue.latency.seconds"], "Found a duplicate in the metrics slice: splunk.indexer.queue.latency.seconds")
+					validatedMetrics["splunk.indexer.queue.latency.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge approximating how long data waits in each of the indexer pipeline queues, as the current size of the queue divided by the indexer throughput. Not reported while the indexer is idle", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.queue.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.queue.name-val", attrVal.Str())
+				case "splunk.indexer.queue.ratio":
+					assert.False(t, validatedMetrics["splunk.indexer.queue.ratio"], "Found a duplicate in the metrics slice: splunk.indexer.queue.ratio")
+					validatedMetrics["splunk.indexer.queue.ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how full each of the indexer pipeline queues is, as a ratio of its current size to its maximum size", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.queue.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.queue.name-val", attrVal.Str())
+				case "splunk.indexer.throughput":
+					assert.False(t, validatedMetrics["splunk.indexer.throughput"], "Found a duplicate in the metrics slice: splunk.indexer.throughput")
+					validatedMetrics["splunk.indexer.throughput"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking average bytes per second throughput of indexer", ms.At(i).Description())
+					assert.Equal(t, "By/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.indexer.status")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.indexer.status-val", attrVal.Str())
+				case "splunk.indexer.throughput.by_sourcetype":
+					assert.False(t, validatedMetrics["splunk.indexer.throughput.by_sourcetype"], "Found a duplicate in the metrics slice: splunk.indexer.throughput.by_sourcetype")
+					validatedMetrics["splunk.indexer.throughput.by_sourcetype"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking average bytes per second throughput of indexer per source type over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "By/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.sourcetype.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.sourcetype.name-val", attrVal.Str())
+				case "splunk.indexes.count":
+					assert.False(t, validatedMetrics["splunk.indexes.count"], "Found a duplicate in the metrics slice: splunk.indexes.count")
+					validatedMetrics["splunk.indexes.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of indexes by the type of data they hold. Sum over the types for the total number of indexes", ms.At(i).Description())
+					assert.Equal(t, "{indexes}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.type-val", attrVal.Str())
+				case "splunk.kvstore.backup.restore.status":
+					assert.False(t, validatedMetrics["splunk.kvstore.backup.restore.status"], "Found a duplicate in the metrics slice: splunk.kvstore.backup.restore.status")
+					validatedMetrics["splunk.kvstore.backup.restore.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the health of KV store backup and restore, 1 when it is ready and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.kvstore.status.value")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.kvstore.status.value-val", attrVal.Str())
+				case "splunk.kvstore.collection.count":
+					assert.False(t, validatedMetrics["splunk.kvstore.collection.count"], "Found a duplicate in the metrics slice: splunk.kvstore.collection.count")
+					validatedMetrics["splunk.kvstore.collection.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of records held by a KV store collection", ms.At(i).Description())
+					assert.Equal(t, "{records}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.kvstore.collection.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.kvstore.collection.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.app.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+				case "splunk.kvstore.collection.size.bytes":
+					assert.False(t, validatedMetrics["splunk.kvstore.collection.size.bytes"], "Found a duplicate in the metrics slice: splunk.kvstore.collection.size.bytes")
+					validatedMetrics["splunk.kvstore.collection.size.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size of the records of a KV store collection, before compression", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.kvstore.collection.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.kvstore.collection.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.app.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+				case "splunk.kvstore.replication.status":
+					assert.False(t, validatedMetrics["splunk.kvstore.replication.status"], "Found a duplicate in the metrics slice: splunk.kvstore.replication.status")
+					validatedMetrics["splunk.kvstore.replication.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the health of KV store replication, 1 when this member is the captain or an in sync member and 0 otherwise. Not reported on standalone instances", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.kvstore.status.value")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.kvstore.status.value-val", attrVal.Str())
+				case "splunk.kvstore.status":
+					assert.False(t, validatedMetrics["splunk.kvstore.status"], "Found a duplicate in the metrics slice: splunk.kvstore.status")
+					validatedMetrics["splunk.kvstore.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the health of the KV store, 1 when it is ready and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.kvstore.status.value")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.kvstore.status.value-val", attrVal.Str())
+				case "splunk.license.index.usage":
+					assert.False(t, validatedMetrics["splunk.license.index.usage"], "Found a duplicate in the metrics slice: splunk.license.index.usage")
+					validatedMetrics["splunk.license.index.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the indexed license usage per index", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.license.pool.quota.bytes":
+					assert.False(t, validatedMetrics["splunk.license.pool.quota.bytes"], "Found a duplicate in the metrics slice: splunk.license.pool.quota.bytes")
+					validatedMetrics["splunk.license.pool.quota.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the daily license volume allotted to a license pool. Not reported for pools drawing on the whole stack quota", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.license.pool.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.license.pool.name-val", attrVal.Str())
+				case "splunk.license.pool.used.bytes":
+					assert.False(t, validatedMetrics["splunk.license.pool.used.bytes"], "Found a duplicate in the metrics slice: splunk.license.pool.used.bytes")
+					validatedMetrics["splunk.license.pool.used.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the license volume used today by a license pool", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.license.pool.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.license.pool.name-val", attrVal.Str())
+				case "splunk.license.slave.count":
+					assert.False(t, validatedMetrics["splunk.license.slave.count"], "Found a duplicate in the metrics slice: splunk.license.slave.count")
+					validatedMetrics["splunk.license.slave.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of license peers reporting to the license manager", ms.At(i).Description())
+					assert.Equal(t, "{peers}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.license.violation":
+					assert.False(t, validatedMetrics["splunk.license.violation"], "Found a duplicate in the metrics slice: splunk.license.violation")
+					validatedMetrics["splunk.license.violation"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking whether the license manager reports a license violation, 1 when it reports an error level message and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.license.warning.count":
+					assert.False(t, validatedMetrics["splunk.license.warning.count"], "Found a duplicate in the metrics slice: splunk.license.warning.count")
+					validatedMetrics["splunk.license.warning.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of license warnings the license manager currently reports for a license pool. Warnings not tied to a pool are reported with an empty pool name", ms.At(i).Description())
+					assert.Equal(t, "{warnings}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.license.pool.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.license.pool.name-val", attrVal.Str())
+				case "splunk.pipeline.cpu.seconds":
+					assert.False(t, validatedMetrics["splunk.pipeline.cpu.seconds"], "Found a duplicate in the metrics slice: splunk.pipeline.cpu.seconds")
+					validatedMetrics["splunk.pipeline.cpu.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the CPU time spent by an indexer pipeline processor over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.pipeline.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.pipeline.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.pipeline.processor.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.pipeline.processor.name-val", attrVal.Str())
+				case "splunk.process.cpu.percent":
+					assert.False(t, validatedMetrics["splunk.process.cpu.percent"], "Found a duplicate in the metrics slice: splunk.process.cpu.percent")
+					validatedMetrics["splunk.process.cpu.percent"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the percentage of CPU used by the Splunk processes sharing a name", ms.At(i).Description())
+					assert.Equal(t, "%", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.process.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.process.name-val", attrVal.Str())
+				case "splunk.process.memory.bytes":
+					assert.False(t, validatedMetrics["splunk.process.memory.bytes"], "Found a duplicate in the metrics slice: splunk.process.memory.bytes")
+					validatedMetrics["splunk.process.memory.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the memory used by the Splunk processes sharing a name", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.process.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.process.name-val", attrVal.Str())
+				case "splunk.receiver.search.wait.seconds":
+					assert.False(t, validatedMetrics["splunk.receiver.search.wait.seconds"], "Found a duplicate in the metrics slice: splunk.receiver.search.wait.seconds")
+					validatedMetrics["splunk.receiver.search.wait.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how long the receiver waited on the last run of a search it dispatched, from dispatch until its results were ready. Useful for tuning max_search_wait_time", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.search.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.search.name-val", attrVal.Str())
+				case "splunk.savedsearch.alert.fired.count":
+					assert.False(t, validatedMetrics["splunk.savedsearch.alert.fired.count"], "Found a duplicate in the metrics slice: splunk.savedsearch.alert.fired.count")
+					validatedMetrics["splunk.savedsearch.alert.fired.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of times a saved search fired its alert over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "{alerts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.savedsearch.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.savedsearch.name-val", attrVal.Str())
+				case "splunk.savedsearch.alert.suppressed.count":
+					assert.False(t, validatedMetrics["splunk.savedsearch.alert.suppressed.count"], "Found a duplicate in the metrics slice: splunk.savedsearch.alert.suppressed.count")
+					validatedMetrics["splunk.savedsearch.alert.suppressed.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of times the alert of a saved search was suppressed by its throttling settings over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "{alerts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.savedsearch.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.savedsearch.name-val", attrVal.Str())
+				case "splunk.scheduler.execution.duration":
+					assert.False(t, validatedMetrics["splunk.scheduler.execution.duration"], "Found a duplicate in the metrics slice: splunk.scheduler.execution.duration")
+					validatedMetrics["splunk.scheduler.execution.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average run time of a saved search over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.savedsearch.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.savedsearch.name-val", attrVal.Str())
+				case "splunk.scheduler.lag.seconds":
+					assert.False(t, validatedMetrics["splunk.scheduler.lag.seconds"], "Found a duplicate in the metrics slice: splunk.scheduler.lag.seconds")
+					validatedMetrics["splunk.scheduler.lag.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average delay between a saved search's scheduled time and its dispatch over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.savedsearch.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.savedsearch.name-val", attrVal.Str())
+				case "splunk.scheduler.oldest.queued.seconds":
+					assert.False(t, validatedMetrics["splunk.scheduler.oldest.queued.seconds"], "Found a duplicate in the metrics slice: splunk.scheduler.oldest.queued.seconds")
+					validatedMetrics["splunk.scheduler.oldest.queued.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how long the search that has been queued the longest has been waiting to run, 0 when no search is queued", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "splunk.scheduler.skipped.count":
+					assert.False(t, validatedMetrics["splunk.scheduler.skipped.count"], "Found a duplicate in the metrics slice: splunk.scheduler.skipped.count")
+					validatedMetrics["splunk.scheduler.skipped.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of times a saved search was skipped by the scheduler over the last 10 minutes, by the reason it was skipped for", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.savedsearch.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.savedsearch.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.scheduler.skip.reason")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.scheduler.skip.reason-val", attrVal.Str())
+				case "splunk.search.cache.hit.ratio":
+					assert.False(t, validatedMetrics["splunk.search.cache.hit.ratio"], "Found a duplicate in the metrics slice: splunk.search.cache.hit.ratio")
+					validatedMetrics["splunk.search.cache.hit.ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the fraction of the lookups of the search results cache that found the results of the search in it since splunkd started", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "splunk.search.count":
+					assert.False(t, validatedMetrics["splunk.search.count"], "Found a duplicate in the metrics slice: splunk.search.count")
+					validatedMetrics["splunk.search.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of searches run from an app over the last 10 minutes", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.app.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+				case "splunk.search.event.count":
+					assert.False(t, validatedMetrics["splunk.search.event.count"], "Found a duplicate in the metrics slice: splunk.search.event.count")
+					validatedMetrics["splunk.search.event.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events returned by the last run of a search dispatched by the receiver", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.search.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.search.name-val", attrVal.Str())
+				case "splunk.search.run.duration.seconds":
+					assert.False(t, validatedMetrics["splunk.search.run.duration.seconds"], "Found a duplicate in the metrics slice: splunk.search.run.duration.seconds")
+					validatedMetrics["splunk.search.run.duration.seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how long the last run of a search dispatched by the receiver took to complete", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.search.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.search.name-val", attrVal.Str())
+				case "splunk.search.scan.count":
+					assert.False(t, validatedMetrics["splunk.search.scan.count"], "Found a duplicate in the metrics slice: splunk.search.scan.count")
+					validatedMetrics["splunk.search.scan.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events scanned by the last run of a search dispatched by the receiver", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.search.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.search.name-val", attrVal.Str())
+				case "splunk.search.timeout.count":
+					assert.False(t, validatedMetrics["splunk.search.timeout.count"], "Found a duplicate in the metrics slice: splunk.search.timeout.count")
+					validatedMetrics["splunk.search.timeout.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times a search dispatched by the receiver was given up on for exceeding max_search_wait_time since the receiver started", ms.At(i).Description())
+					assert.Equal(t, "{timeouts}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.search.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.search.name-val", attrVal.Str())
+				case "splunk.searches.limit":
+					assert.False(t, validatedMetrics["splunk.searches.limit"], "Found a duplicate in the metrics slice: splunk.searches.limit")
+					validatedMetrics["splunk.searches.limit"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of historical searches the instance runs at once before it starts queueing them", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.searches.queued.count":
+					assert.False(t, validatedMetrics["splunk.searches.queued.count"], "Found a duplicate in the metrics slice: splunk.searches.queued.count")
+					validatedMetrics["splunk.searches.queued.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of searches waiting for the instance to run them", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.searches.realtime.limit":
+					assert.False(t, validatedMetrics["splunk.searches.realtime.limit"], "Found a duplicate in the metrics slice: splunk.searches.realtime.limit")
+					validatedMetrics["splunk.searches.realtime.limit"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of real-time searches the instance runs at once before it starts queueing them", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.searches.realtime.running.count":
+					assert.False(t, validatedMetrics["splunk.searches.realtime.running.count"], "Found a duplicate in the metrics slice: splunk.searches.realtime.running.count")
+					validatedMetrics["splunk.searches.realtime.running.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of real-time searches running on the instance", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.searches.running.count":
+					assert.False(t, validatedMetrics["splunk.searches.running.count"], "Found a duplicate in the metrics slice: splunk.searches.running.count")
+					validatedMetrics["splunk.searches.running.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of searches running on the instance", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.server.cpu.usage.percent":
+					assert.False(t, validatedMetrics["splunk.server.cpu.usage.percent"], "Found a duplicate in the metrics slice: splunk.server.cpu.usage.percent")
+					validatedMetrics["splunk.server.cpu.usage.percent"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the percentage of CPU in use on the host, as seen by Splunk", ms.At(i).Description())
+					assert.Equal(t, "%", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "splunk.server.memory.usage.bytes":
+					assert.False(t, validatedMetrics["splunk.server.memory.usage.bytes"], "Found a duplicate in the metrics slice: splunk.server.memory.usage.bytes")
+					validatedMetrics["splunk.server.memory.usage.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the memory in use on the host, as seen by Splunk", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.server.partition.capacity.bytes":
+					assert.False(t, validatedMetrics["splunk.server.partition.capacity.bytes"], "Found a duplicate in the metrics slice: splunk.server.partition.capacity.bytes")
+					validatedMetrics["splunk.server.partition.capacity.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size of a partition Splunk writes to", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.partition.mountpoint")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.partition.mountpoint-val", attrVal.Str())
+				case "splunk.server.partition.free.bytes":
+					assert.False(t, validatedMetrics["splunk.server.partition.free.bytes"], "Found a duplicate in the metrics slice: splunk.server.partition.free.bytes")
+					validatedMetrics["splunk.server.partition.free.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the space left on a partition Splunk writes to. Splunk stops indexing once it drops below the minFreeSpace setting of server.conf", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.partition.mountpoint")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.partition.mountpoint-val", attrVal.Str())
+				case "splunk.server.partition.used.bytes":
+					assert.False(t, validatedMetrics["splunk.server.partition.used.bytes"], "Found a duplicate in the metrics slice: splunk.server.partition.used.bytes")
+					validatedMetrics["splunk.server.partition.used.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the space used on a partition Splunk writes to", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.partition.mountpoint")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.partition.mountpoint-val", attrVal.Str())
+				case "splunk.shc.artifact.replication.failures":
+					assert.False(t, validatedMetrics["splunk.shc.artifact.replication.failures"], "Found a duplicate in the metrics slice: splunk.shc.artifact.replication.failures")
+					validatedMetrics["splunk.shc.artifact.replication.failures"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of artifact replication jobs targeting a search head cluster member that the captain lists as failed", ms.At(i).Description())
+					assert.Equal(t, "{jobs}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.shc.peer.guid")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.shc.peer.guid-val", attrVal.Str())
+				case "splunk.shc.captain.election.count":
+					assert.False(t, validatedMetrics["splunk.shc.captain.election.count"], "Found a duplicate in the metrics slice: splunk.shc.captain.election.count")
+					validatedMetrics["splunk.shc.captain.election.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of search head cluster captain elections observed since the receiver started", ms.At(i).Description())
+					assert.Equal(t, "{elections}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.shc.member.status":
+					assert.False(t, validatedMetrics["splunk.shc.member.status"], "Found a duplicate in the metrics slice: splunk.shc.member.status")
+					validatedMetrics["splunk.shc.member.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the health of this search head cluster member, 1 when it is up and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.shc.member.status.value")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.shc.member.status.value-val", attrVal.Str())
+				case "splunk.shc.replication.pending.count":
+					assert.False(t, validatedMetrics["splunk.shc.replication.pending.count"], "Found a duplicate in the metrics slice: splunk.shc.replication.pending.count")
+					validatedMetrics["splunk.shc.replication.pending.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of replication jobs the captain has pending for a search head cluster member", ms.At(i).Description())
+					assert.Equal(t, "{jobs}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.shc.peer.guid")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.shc.peer.guid-val", attrVal.Str())
+				case "splunk.shc.replication.status":
+					assert.False(t, validatedMetrics["splunk.shc.replication.status"], "Found a duplicate in the metrics slice: splunk.shc.replication.status")
+					validatedMetrics["splunk.shc.replication.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking whether this member takes part in search artifact replication, 1 when it is registered with the captain and replicating and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.smartstore.cache.hit.ratio":
+					assert.False(t, validatedMetrics["splunk.smartstore.cache.hit.ratio"], "Found a duplicate in the metrics slice: splunk.smartstore.cache.hit.ratio")
+					validatedMetrics["splunk.smartstore.cache.hit.ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the fraction of the lookups of the SmartStore cache of an index that found the bucket in the cache since splunkd started", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.smartstore.eviction.count":
+					assert.False(t, validatedMetrics["splunk.smartstore.eviction.count"], "Found a duplicate in the metrics slice: splunk.smartstore.eviction.count")
+					validatedMetrics["splunk.smartstore.eviction.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of buckets of a SmartStore index evicted from the cache since splunkd started", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.smartstore.upload.pending.count":
+					assert.False(t, validatedMetrics["splunk.smartstore.upload.pending.count"], "Found a duplicate in the metrics slice: splunk.smartstore.upload.pending.count")
+					validatedMetrics["splunk.smartstore.upload.pending.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of buckets of a SmartStore index waiting to be uploaded to remote storage", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.sourcetype.event.count":
+					assert.False(t, validatedMetrics["splunk.sourcetype.event.count"], "Found a duplicate in the metrics slice: splunk.sourcetype.event.count")
+					validatedMetrics["splunk.sourcetype.event.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events indexed per source type over the search time range, all time unless search_earliest_time or search_latest_time is set", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.sourcetype.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.sourcetype.name-val", attrVal.Str())
+				case "splunk.up":
+					assert.False(t, validatedMetrics["splunk.up"], "Found a duplicate in the metrics slice: splunk.up")
+					validatedMetrics["splunk.up"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking whether the Splunk management port answered during the scrape, 1 when it did and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.user.dispatch.quota.limit":
+					assert.False(t, validatedMetrics["splunk.user.dispatch.quota.limit"], "Found a duplicate in the metrics slice: splunk.user.dispatch.quota.limit")
+					validatedMetrics["splunk.user.dispatch.quota.limit"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of historical searches a user can run at once, the highest srchJobsQuota of their roles", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.user.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.user.name-val", attrVal.Str())
+				case "splunk.user.dispatch.quota.used":
+					assert.False(t, validatedMetrics["splunk.user.dispatch.quota.used"], "Found a duplicate in the metrics slice: splunk.user.dispatch.quota.used")
+					validatedMetrics["splunk.user.dispatch.quota.used"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of historical searches a user is running against their search job quota", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.user.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.user.name-val", attrVal.Str())
 				}
 			}
 		})
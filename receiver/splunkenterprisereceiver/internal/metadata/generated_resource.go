@@ -0,0 +1,64 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// ResourceBuilder is a helper struct to build resources predefined in metadata.yaml.
+// The ResourceBuilder is not thread-safe and must not to be used in multiple goroutines.
+type ResourceBuilder struct {
+	config ResourceAttributesConfig
+	res    pcommon.Resource
+}
+
+// NewResourceBuilder creates a new ResourceBuilder. This method should be called on the start of the application.
+func NewResourceBuilder(rac ResourceAttributesConfig) *ResourceBuilder {
+	return &ResourceBuilder{
+		config: rac,
+		res:    pcommon.NewResource(),
+	}
+}
+
+// SetSplunkInstance sets provided value as "splunk.instance" attribute.
+func (rb *ResourceBuilder) SetSplunkInstance(val string) {
+	if rb.config.SplunkInstance.Enabled {
+		rb.res.Attributes().PutStr("splunk.instance", val)
+	}
+}
+
+// SetSplunkServerGUID sets provided value as "splunk.server.guid" attribute.
+func (rb *ResourceBuilder) SetSplunkServerGUID(val string) {
+	if rb.config.SplunkServerGUID.Enabled {
+		rb.res.Attributes().PutStr("splunk.server.guid", val)
+	}
+}
+
+// SetSplunkServerName sets provided value as "splunk.server.name" attribute.
+func (rb *ResourceBuilder) SetSplunkServerName(val string) {
+	if rb.config.SplunkServerName.Enabled {
+		rb.res.Attributes().PutStr("splunk.server.name", val)
+	}
+}
+
+// SetSplunkShcMemberGUID sets provided value as "splunk.shc.member.guid" attribute.
+func (rb *ResourceBuilder) SetSplunkShcMemberGUID(val string) {
+	if rb.config.SplunkShcMemberGUID.Enabled {
+		rb.res.Attributes().PutStr("splunk.shc.member.guid", val)
+	}
+}
+
+// SetSplunkVersion sets provided value as "splunk.version" attribute.
+func (rb *ResourceBuilder) SetSplunkVersion(val string) {
+	if rb.config.SplunkVersion.Enabled {
+		rb.res.Attributes().PutStr("splunk.version", val)
+	}
+}
+
+// Emit returns the built resource and resets the internal builder state.
+func (rb *ResourceBuilder) Emit() pcommon.Resource {
+	r := rb.res
+	rb.res = pcommon.NewResource()
+	return r
+}
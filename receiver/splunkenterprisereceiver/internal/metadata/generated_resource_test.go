@@ -0,0 +1,64 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBuilder(t *testing.T) {
+	for _, test := range []string{"default", "all_set", "none_set"} {
+		t.Run(test, func(t *testing.T) {
+			cfg := loadResourceAttributesConfig(t, test)
+			rb := NewResourceBuilder(cfg)
+			rb.SetSplunkInstance("splunk.instance-val")
+			rb.SetSplunkServerGUID("splunk.server.guid-val")
+			rb.SetSplunkServerName("splunk.server.name-val")
+			rb.SetSplunkShcMemberGUID("splunk.shc.member.guid-val")
+			rb.SetSplunkVersion("splunk.version-val")
+
+			res := rb.Emit()
+			assert.Equal(t, 0, rb.Emit().Attributes().Len()) // Second call should return empty Resource
+
+			switch test {
+			case "default":
+				assert.Equal(t, 5, res.Attributes().Len())
+			case "all_set":
+				assert.Equal(t, 5, res.Attributes().Len())
+			case "none_set":
+				assert.Equal(t, 0, res.Attributes().Len())
+				return
+			default:
+				assert.Failf(t, "unexpected test case: %s", test)
+			}
+
+			val, ok := res.Attributes().Get("splunk.instance")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "splunk.instance-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("splunk.server.guid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "splunk.server.guid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("splunk.server.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "splunk.server.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("splunk.shc.member.guid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "splunk.shc.member.guid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("splunk.version")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "splunk.version-val", val.Str())
+			}
+		})
+	}
+}
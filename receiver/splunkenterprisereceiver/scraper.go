@@ -10,8 +10,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -19,177 +25,2647 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
 )
 
 var (
 	errMaxSearchWaitTimeExceeded = errors.New("Maximum search wait time exceeded for metric")
+	errCorruptSearchResponse     = errors.New("Failed to unmarshall search response")
+	errSearchJobFailed           = errors.New("Search job will not produce results")
+	errResultsReadTimeout        = errors.New("Timed out reading search results")
+	errSearchDispatchRejected    = errors.New("Search dispatch rejected")
+	// the instance does not expose the endpoint, usually because it is not running the feature behind it
+	errNotFound = errors.New("Endpoint not found")
+	// the account we authenticate as is not allowed to use the endpoint, which for some
+	// endpoints also means the instance is not running the feature behind it
+	errForbidden = errors.New("Endpoint forbidden")
 )
 
+// dispatch states of search jobs that are never going to produce results
+var failedDispatchStates = map[string]bool{
+	"FAILED": true,
+	"PAUSED": true,
+	"ZOMBIE": true,
+}
+
+// indexer pipeline queues reported by splunk.indexer.queue.ratio, keyed by their lowercased name
+var pipelineQueues = map[string]bool{
+	"parsingqueue": true,
+	"aggqueue":     true,
+	"typingqueue":  true,
+	"indexqueue":   true,
+}
+
 type splunkScraper struct {
+	settings component.TelemetrySettings
+	conf     *Config
+	// allow-list built from Config.SavedSearches, empty allows every saved search
+	savedSearches map[string]bool
+	// allow-list built from Config.Sourcetypes, empty allows every source type
+	sourcetypes map[string]bool
+	// allow-list built from Config.Apps, empty allows every app
+	apps map[string]bool
+	// allow-list built from Config.Users, empty allows every user
+	users map[string]bool
+	// Config.IndexIncludeRegex and Config.IndexExcludeRegex, compiled by start. nil when not set
+	indexInclude *regexp.Regexp
+	indexExclude *regexp.Regexp
+	// apiDict with Config.EndpointOverrides applied, set by start
+	api       map[string]string
+	instances []*instanceScraper
+	// passed on to the client of every instance
+	clientOptions []clientOption
+}
+
+// Scrapes a single Splunk instance. Every instance has its own client and MetricsBuilder so
+// its metrics end up under a resource of their own
+type instanceScraper struct {
+	*splunkScraper
+	instance     InstanceConfig
 	splunkClient *splunkEntClient
-	settings     component.TelemetrySettings
-	conf         *Config
 	mb           *metadata.MetricsBuilder
+	// scrape functions run concurrently so access to the MetricsBuilder must be serialized
+	mbMux sync.Mutex
+	// server info of the instance, requested until it is first obtained and cached from then on.
+	// Scrape functions ask for it concurrently so it is guarded by infoMux
+	info    *serverInfoContent
+	infoMux sync.Mutex
+	// set by scrapeSHCStatus when the instance is a search head cluster member
+	shcMemberGUID string
+	// election time of the last captain seen and the number of elections seen since
+	shcLastElection float64
+	shcElections    int64
+	// times every search gave up on MaxSearchWaitTime since the receiver started, guarded by mbMux
+	searchTimeouts map[string]int64
+	// where bucket rolls and freezes are learned from, see Config.BucketEventsSource
+	bucketEvents bucketEventSource
+	// built-in searches run during the current scrape, keyed by their searchDict entry
+	searches    map[string]*sharedSearch
+	searchesMux sync.Mutex
+}
+
+// A built-in search run once per scrape on behalf of every scrape function computing metrics from
+// it. Whoever asks first dispatches it, the others wait on its results
+type sharedSearch struct {
+	once    sync.Once
+	results []searchResult
+	err     error
 }
 
-func newSplunkMetricsScraper(params receiver.CreateSettings, cfg *Config) splunkScraper {
-	return splunkScraper{
-		settings: params.TelemetrySettings,
-		conf:     cfg,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, params),
+// Signature shared by every metric scrape function run by scrape
+type scrapeFunc func(context.Context, pcommon.Timestamp, *scrapererror.ScrapeErrors)
+
+func newSplunkMetricsScraper(params receiver.CreateSettings, cfg *Config, opts ...clientOption) *splunkScraper {
+	savedSearches := make(map[string]bool, len(cfg.SavedSearches))
+	for _, name := range cfg.SavedSearches {
+		savedSearches[name] = true
+	}
+
+	sourcetypes := make(map[string]bool, len(cfg.Sourcetypes))
+	for _, name := range cfg.Sourcetypes {
+		sourcetypes[name] = true
+	}
+
+	apps := make(map[string]bool, len(cfg.Apps))
+	for _, name := range cfg.Apps {
+		apps[name] = true
+	}
+
+	users := make(map[string]bool, len(cfg.Users))
+	for _, name := range cfg.Users {
+		users[name] = true
+	}
+
+	s := &splunkScraper{
+		settings:      params.TelemetrySettings,
+		conf:          cfg,
+		savedSearches: savedSearches,
+		sourcetypes:   sourcetypes,
+		apps:          apps,
+		users:         users,
+		clientOptions: opts,
+	}
+
+	for _, inst := range cfg.instances() {
+		is := &instanceScraper{
+			splunkScraper: s,
+			instance:      inst,
+			mb:            metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, params),
+		}
+		is.bucketEvents = newBucketEventSource(is)
+		s.instances = append(s.instances, is)
 	}
+
+	return s
 }
 
-// Create a client instance and add to the splunkScraper
-func (s *splunkScraper) start(_ context.Context, _ component.Host) (err error) {
-	c := newSplunkEntClient(s.conf)
-	s.splunkClient = &c
+// Create a client for every instance. Unless disabled, every instance is sent an authenticated
+// request as well so that rejected credentials fail the receiver right away. An instance we
+// cannot reach is only logged, it may well be back by the time we scrape it
+func (s *splunkScraper) start(ctx context.Context, h component.Host) error {
+	s.api = s.conf.apiEndpoints()
+
+	var err error
+	if s.indexInclude, err = compileIndexRegex(s.conf.IndexIncludeRegex); err != nil {
+		return err
+	}
+	if s.indexExclude, err = compileIndexRegex(s.conf.IndexExcludeRegex); err != nil {
+		return err
+	}
+
+	// there is no point in a client nobody is going to use
+	if !s.anyMetricEnabled() {
+		s.settings.Logger.Warn("Every metric of the Splunk Enterprise receiver is disabled, nothing is going to be scraped")
+		return nil
+	}
+
+	for _, inst := range s.instances {
+		cfg := s.conf.forInstance(inst.instance)
+		client, err := newSplunkEntClient(cfg, h, s.settings, s.clientOptions...)
+		if err != nil {
+			return fmt.Errorf("instance %s: %w", inst.instance.Name, err)
+		}
+		inst.splunkClient = client
+
+		// the management port serves https unless SSL was turned off on it, an http endpoint pointing at
+		// it usually fails every request in ways that hardly hint at the scheme
+		if u, err := url.Parse(cfg.Endpoint); err == nil && u.Scheme == "http" && u.Port() == defaultManagementPort {
+			s.settings.Logger.Warn("The Splunk management port is reached over http, it serves https unless SSL was turned off on it",
+				zap.String("instance", inst.instance.Name), zap.String("endpoint", cfg.Endpoint))
+		}
+
+		// skipping verification is meant to be a stopgap, remind operators it is still on
+		if cfg.TLSSetting.InsecureSkipVerify {
+			s.settings.Logger.Warn("TLS certificate verification is disabled for the Splunk instance",
+				zap.String("instance", inst.instance.Name))
+		}
+
+		if !s.conf.VerifyConnectionOnStart {
+			continue
+		}
+
+		err = client.verifyConnection(ctx, s.api[`SplunkServerInfo`])
+		if errors.Is(err, errRejectedCredentials) {
+			return fmt.Errorf("instance %s: %w", inst.instance.Name, err)
+		}
+		if err != nil {
+			s.settings.Logger.Warn("Failed to verify the connection to the Splunk instance",
+				zap.String("instance", inst.instance.Name), zap.Error(err))
+		}
+	}
 	return nil
 }
 
-// The big one: Describes how all scraping tasks should be performed. Part of the scraper interface
+// The big one: Describes how all scraping tasks should be performed. Part of the scraper interface.
+// Instances are scraped concurrently and each of them reports its own errors, so one that is
+// unreachable never costs us the metrics of the others
 func (s *splunkScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if !s.anyMetricEnabled() {
+		return pmetric.NewMetrics(), nil
+	}
+
+	var wg sync.WaitGroup
+	metrics := make([]pmetric.Metrics, len(s.instances))
+	instanceErrs := make([]error, len(s.instances))
+
+	for i, inst := range s.instances {
+		wg.Add(1)
+		go func(i int, inst *instanceScraper) {
+			defer wg.Done()
+			metrics[i], instanceErrs[i] = inst.scrape(ctx)
+		}(i, inst)
+	}
+	wg.Wait()
+
+	md := pmetric.NewMetrics()
+	errs := &scrapererror.ScrapeErrors{}
+	for i, inst := range s.instances {
+		metrics[i].ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+		if instanceErrs[i] != nil {
+			errs.Add(fmt.Errorf("instance %s: %w", inst.instance.Name, instanceErrs[i]))
+		}
+	}
+
+	return md, errs.Combine()
+}
+
+// Whether any metric is enabled at all. The endpoints CheckEndpoints goes through list every metric,
+// the endpoints themselves don't matter here
+func (s *splunkScraper) anyMetricEnabled() bool {
+	if len(s.instances) == 0 {
+		return false
+	}
+	for _, me := range metricEndpoints(s.conf.MetricsBuilderConfig.Metrics, s.instances[0].bucketEvents, apiDict) {
+		if me.enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// Scrape every enabled metric of the instance
+func (s *instanceScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var wg sync.WaitGroup
 	errs := &scrapererror.ScrapeErrors{}
 	now := pcommon.NewTimestampFromTime(time.Now())
+	s.shcMemberGUID = ""
+	s.splunkClient.responded.Store(false)
+	s.searchesMux.Lock()
+	s.searches = nil
+	s.searchesMux.Unlock()
+
+	// every scrape function along with whether any of the metrics it records is enabled, the
+	// same check it returns early on
+	m := s.conf.MetricsBuilderConfig.Metrics
+	metricScrapes := []struct {
+		fn      scrapeFunc
+		enabled bool
+	}{
+		{s.scrapeLicenseUsageByIndex, m.SplunkLicenseIndexUsage.Enabled},
+		{s.scrapeLicensePools, m.SplunkLicensePoolUsedBytes.Enabled || m.SplunkLicensePoolQuotaBytes.Enabled ||
+			m.SplunkLicenseSlaveCount.Enabled},
+		{s.scrapeLicenseViolations, m.SplunkLicenseWarningCount.Enabled || m.SplunkLicenseViolation.Enabled},
+		{s.scrapeIndexThroughput, m.SplunkIndexerThroughput.Enabled},
+		{s.scrapeSourcetypeThroughput, m.SplunkIndexerThroughputBySourcetype.Enabled},
+		{s.scrapeSourcetypeVolume, m.SplunkSourcetypeEventCount.Enabled},
+		{s.scrapeIngestionLatency, m.SplunkIndexerIngestionLatencySeconds.Enabled},
+		{s.scrapeIndexEventRates, m.SplunkIndexEventsWrittenRate.Enabled || m.SplunkIndexEventsSearchedRate.Enabled},
+		{s.scrapeIndexSearchDuration, m.SplunkIndexSearchDurationSeconds.Enabled},
+		{s.scrapeSearchActivityByApp, m.SplunkSearchCount.Enabled},
+		{s.scrapeIndexerQueues, m.SplunkIndexerQueueRatio.Enabled || m.SplunkIndexerQueueLatencySeconds.Enabled},
+		{s.scrapeSchedulerMetrics, m.SplunkSchedulerSkippedCount.Enabled || m.SplunkSchedulerLagSeconds.Enabled ||
+			m.SplunkSchedulerExecutionDuration.Enabled},
+		{s.scrapeSavedSearchAlerts, m.SplunkSavedsearchAlertFiredCount.Enabled || m.SplunkSavedsearchAlertSuppressedCount.Enabled},
+		{s.scrapeFiredAlerts, m.SplunkAlertsTriggeredCount.Enabled || m.SplunkAlertsTriggeredOldestSeconds.Enabled},
+		{s.scrapeKVStoreStatus, m.SplunkKvstoreStatus.Enabled || m.SplunkKvstoreReplicationStatus.Enabled ||
+			m.SplunkKvstoreBackupRestoreStatus.Enabled},
+		{s.scrapeKVStoreCollections, m.SplunkKvstoreCollectionSizeBytes.Enabled || m.SplunkKvstoreCollectionCount.Enabled},
+		{s.scrapeIndexesExtended, m.SplunkIndexBucketCount.Enabled || m.SplunkIndexRawSizeBytes.Enabled ||
+			m.SplunkIndexEventCount.Enabled || m.SplunkIndexEarliestEventSeconds.Enabled ||
+			m.SplunkIndexLatestEventSeconds.Enabled || m.SplunkIndexHotBucketsCount.Enabled ||
+			m.SplunkIndexHotBucketsMax.Enabled || m.SplunkIndexesCount.Enabled ||
+			m.SplunkIndexFrozenTimeSeconds.Enabled || m.SplunkIndexMaxSizeBytes.Enabled ||
+			m.SplunkIndexThawedSizeBytes.Enabled},
+		{s.scrapeBucketEvents, m.SplunkIndexBucketsRolledCount.Enabled || m.SplunkIndexBucketsFrozenCount.Enabled},
+		{s.scrapeSHCStatus, m.SplunkShcMemberStatus.Enabled || m.SplunkShcCaptainElectionCount.Enabled ||
+			m.SplunkShcReplicationStatus.Enabled},
+		{s.scrapeSHCReplication, m.SplunkShcReplicationPendingCount.Enabled || m.SplunkShcArtifactReplicationFailures.Enabled},
+		{s.scrapeDeploymentServer, m.SplunkDeploymentClientsCount.Enabled || m.SplunkDeploymentServerclassClients.Enabled},
+		{s.scrapeServerIntrospection, m.SplunkServerCPUUsagePercent.Enabled || m.SplunkServerMemoryUsageBytes.Enabled ||
+			m.SplunkProcessCPUPercent.Enabled || m.SplunkProcessMemoryBytes.Enabled},
+		{s.scrapeDiskUsage, m.SplunkServerPartitionUsedBytes.Enabled || m.SplunkServerPartitionFreeBytes.Enabled ||
+			m.SplunkServerPartitionCapacityBytes.Enabled},
+		{s.scrapeClusterMaster, m.SplunkClusterIndexSearchable.Enabled || m.SplunkClusterIndexReplicatedCopies.Enabled ||
+			m.SplunkClusterFixupPendingCount.Enabled || m.SplunkClusterReplicationFactor.Enabled ||
+			m.SplunkClusterSearchFactor.Enabled || m.SplunkClusterPeerFixupTasks.Enabled ||
+			m.SplunkClusterPeerStatus.Enabled || m.SplunkClusterPeerReplicationQueueLength.Enabled ||
+			m.SplunkClusterReplicationFactorMet.Enabled || m.SplunkClusterSearchFactorMet.Enabled},
+		{s.scrapeHECStatus, m.SplunkHecDataReceivedBytes.Enabled || m.SplunkHecRequestsCount.Enabled ||
+			m.SplunkHecErrorsCount.Enabled || m.SplunkHecTokenEnabled.Enabled},
+		{s.scrapeSearchConcurrency, m.SplunkSearchesRunningCount.Enabled || m.SplunkSearchesQueuedCount.Enabled ||
+			m.SplunkSearchesRealtimeRunningCount.Enabled || m.SplunkSchedulerOldestQueuedSeconds.Enabled ||
+			m.SplunkSearchesLimit.Enabled || m.SplunkSearchesRealtimeLimit.Enabled},
+		{s.scrapeDataModelAcceleration, m.SplunkDatamodelAccelerationPercent.Enabled || m.SplunkDatamodelAccelerationSizeBytes.Enabled},
+		{s.scrapeForwarderConnections, m.SplunkForwarderConnectionsCount.Enabled || m.SplunkForwarderDataReceivedBytes.Enabled},
+		{s.scrapePipelineCPU, m.SplunkPipelineCPUSeconds.Enabled},
+		{s.scrapeDispatchDirUsage, m.SplunkDispatchArtifactCount.Enabled || m.SplunkDispatchDiskUsedBytes.Enabled},
+		{s.scrapeUserDispatchQuota, m.SplunkUserDispatchQuotaUsed.Enabled || m.SplunkUserDispatchQuotaLimit.Enabled},
+		{s.scrapeSmartStoreCache, m.SplunkSmartstoreCacheHitRatio.Enabled || m.SplunkSmartstoreUploadPendingCount.Enabled ||
+			m.SplunkSmartstoreEvictionCount.Enabled},
+		{s.scrapeSearchResultsCache, m.SplunkSearchCacheHitRatio.Enabled},
+	}
+
+	// ScrapeErrors is not safe for concurrent use, so every scrape function gets its own and they
+	// are merged once all of them have returned. The semaphore keeps us from running more than
+	// MaxConcurrentSearches requests against the Splunk deployment at the same time
+	scrapeErrs := make([]scrapererror.ScrapeErrors, len(metricScrapes))
+	sem := make(chan struct{}, s.conf.MaxConcurrentSearches)
+
+	for i, ms := range metricScrapes {
+		if !ms.enabled {
+			continue
+		}
+		wg.Add(1)
+		go func(fn scrapeFunc, errs *scrapererror.ScrapeErrors) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			fn(ctx, now, errs)
+		}(ms.fn, &scrapeErrs[i])
+	}
+	wg.Wait()
+
+	ok, failed := 0, 0
+	for i := range scrapeErrs {
+		if err := scrapeErrs[i].Combine(); err != nil {
+			errs.Add(err)
+			failed++
+		} else if metricScrapes[i].enabled {
+			ok++
+		}
+	}
+
+	if s.conf.MetricsBuilderConfig.Metrics.SplunkSearchTimeoutCount.Enabled {
+		for name, count := range s.searchTimeouts {
+			s.mb.RecordSplunkSearchTimeoutCountDataPoint(now, count, name)
+		}
+	}
+
+	// only logins can be told apart from missing capabilities, without session keys there are none
+	if s.conf.MetricsBuilderConfig.Metrics.SplunkAuthFailuresCount.Enabled && s.splunkClient.session != nil {
+		s.mb.RecordSplunkAuthFailuresCountDataPoint(now, s.splunkClient.rejectedLogins())
+	}
+
+	if s.conf.MetricsBuilderConfig.Metrics.SplunkUp.Enabled {
+		var up int64
+		if s.reachable(ctx) {
+			up = 1
+		}
+		s.mb.RecordSplunkUpDataPoint(now, up)
+	}
+
+	rb := s.mb.NewResourceBuilder()
+	rb.SetSplunkInstance(s.instance.Name)
+	rac := s.conf.MetricsBuilderConfig.ResourceAttributes
+	if rac.SplunkServerGUID.Enabled || rac.SplunkServerName.Enabled || rac.SplunkVersion.Enabled {
+		if info := s.serverInfo(ctx); info != nil {
+			rb.SetSplunkServerGUID(info.GUID)
+			rb.SetSplunkServerName(info.ServerName)
+			rb.SetSplunkVersion(info.Version)
+		}
+	}
+	if s.shcMemberGUID != "" {
+		rb.SetSplunkShcMemberGUID(s.shcMemberGUID)
+	}
+
+	md := s.mb.Emit(metadata.WithResource(rb.Emit()))
+
+	// a line per scrape tells a healthy receiver apart without debug logs. Scrape functions with
+	// none of their metrics enabled are not run and count as neither
+	s.settings.Logger.Info("Splunk scrape complete", zap.String("instance", s.instance.Name),
+		zap.Int("ok", ok), zap.Int("failed", failed), zap.Int("datapoints", md.DataPointCount()))
+
+	return md, errs.Combine()
+}
+
+// Returns the cached server info of the instance, requesting it first if we don't hold it yet.
+// Failing to get it only costs us the resource attributes describing the instance and telling
+// search head cluster members apart, so it is merely logged and asked for again on the next scrape
+func (s *instanceScraper) serverInfo(ctx context.Context) *serverInfoContent {
+	var info serverInfo
+
+	s.infoMux.Lock()
+	defer s.infoMux.Unlock()
+
+	if s.info != nil {
+		return s.info
+	}
+
+	if err := s.getAPI(ctx, s.api[`SplunkServerInfo`], &info); err != nil {
+		s.settings.Logger.Debug("Failed to get server info", zap.String("instance", s.instance.Name), zap.Error(err))
+		return nil
+	}
+
+	for _, entry := range info.Entries {
+		content := entry.Content
+		s.info = &content
+	}
+
+	return s.info
+}
 
-	s.scrapeLicenseUsageByIndex(ctx, now, errs)
-	s.scrapeIndexThroughput(ctx, now, errs)
-	return s.mb.Emit(), errs.Combine()
+// Whether the deployment answered any request made during the scrape. When it answered none,
+// possibly because every other metric is disabled, it is probed once more through server info
+func (s *instanceScraper) reachable(ctx context.Context) bool {
+	if s.splunkClient.responded.Load() {
+		return true
+	}
+
+	req, err := s.splunkClient.createAPIRequest(ctx, s.api[`SplunkServerInfo`])
+	if err != nil {
+		return false
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		s.settings.Logger.Debug("Splunk deployment is unreachable", zap.Error(err))
+		return false
+	}
+	res.Body.Close()
+
+	return true
 }
 
 // Each metric has its own scrape function associated with it
-func (s *splunkScraper) scrapeLicenseUsageByIndex(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	var sr searchResponse
+func (s *instanceScraper) scrapeLicenseUsageByIndex(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
 	if !s.conf.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled {
 		return
 	}
 
-	sr = searchResponse{
-		search: searchDict[`SplunkLicenseIndexUsageSearch`],
+	rows := s.searchMetricRows(ctx, now, map[string]bool{"splunk.license.index.usage": true}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	// a value that fails to parse only costs us the datapoint of its own row
+	for _, row := range rows["splunk.license.index.usage"] {
+		v, err := strconv.ParseFloat(row.value, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkLicenseIndexUsageDataPoint(now, int64(v), row.attribute)
 	}
+}
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+// Scrape how much of its quota every license pool has used, and how many license peers there are
+func (s *instanceScraper) scrapeLicensePools(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var pools []lpEntry
+	var peers int64
 
-	start := time.Now()
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	usage := metrics.SplunkLicensePoolUsedBytes.Enabled || metrics.SplunkLicensePoolQuotaBytes.Enabled
+	countPeers := metrics.SplunkLicenseSlaveCount.Enabled
+	if !usage && !countPeers {
+		return
+	}
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
+	if usage {
+		err := s.getAllPages(ctx, s.api[`SplunkLicensePools`], func(body []byte) (paging, int, error) {
+			var lp licensePools
+			if err := json.Unmarshal(body, &lp); err != nil {
+				return paging{}, 0, err
+			}
+			pools = append(pools, lp.Entries...)
+			return lp.Paging, len(lp.Entries), nil
+		})
 		if err != nil {
 			errs.Add(err)
-			return
 		}
+	}
 
-		res, err = s.splunkClient.makeRequest(req)
+	if countPeers {
+		err := s.getAllPages(ctx, s.api[`SplunkLicenseSlaves`], func(body []byte) (paging, int, error) {
+			var ls licenseSlaves
+			if err := json.Unmarshal(body, &ls); err != nil {
+				return paging{}, 0, err
+			}
+			peers += int64(len(ls.Entries))
+			return ls.Paging, len(ls.Entries), nil
+		})
 		if err != nil {
 			errs.Add(err)
-			return
+			countPeers = false
 		}
+	}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, pool := range pools {
+		if pool.Content.UsedBytes.ok {
+			s.mb.RecordSplunkLicensePoolUsedBytesDataPoint(now, int64(pool.Content.UsedBytes.value), pool.Name)
+		}
+		if pool.Content.Quota.ok {
+			s.mb.RecordSplunkLicensePoolQuotaBytesDataPoint(now, int64(pool.Content.Quota.value), pool.Name)
+		}
+	}
+
+	if countPeers {
+		s.mb.RecordSplunkLicenseSlaveCountDataPoint(now, peers)
+	}
+}
+
+// Scrape the warnings and violations the license manager holds. Splunk locks search once the
+// violation is hard, the warnings leading up to it are what operators want to alert on
+func (s *instanceScraper) scrapeLicenseViolations(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	warnings := map[string]int64{}
+	var violation int64
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkLicenseWarningCount.Enabled && !metrics.SplunkLicenseViolation.Enabled {
+		return
+	}
+
+	err := s.getAllPages(ctx, s.api[`SplunkLicenseMessages`], func(body []byte) (paging, int, error) {
+		var lm licenseMessages
+		if err := json.Unmarshal(body, &lm); err != nil {
+			return paging{}, 0, err
+		}
+		for _, entry := range lm.Entries {
+			switch strings.ToUpper(entry.Content.Severity) {
+			case "WARN":
+				warnings[entry.Content.PoolID]++
+			case "ERROR":
+				violation = 1
+			}
+		}
+		return lm.Paging, len(lm.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for pool, count := range warnings {
+		s.mb.RecordSplunkLicenseWarningCountDataPoint(now, count, pool)
+	}
+	s.mb.RecordSplunkLicenseViolationDataPoint(now, violation)
+}
+
+// Scrape how often saved searches get skipped and why, how late they get dispatched and how long
+// they run from the scheduler's logs. Skips are counted by a search of their own, broken down by
+// the reason the scheduler logged mapped to a handful of values, so a saved search only reports
+// the reasons it was skipped for
+func (s *instanceScraper) scrapeSchedulerMetrics(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkSchedulerSkippedCount.Enabled && !metrics.SplunkSchedulerLagSeconds.Enabled &&
+		!metrics.SplunkSchedulerExecutionDuration.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{
+		"splunk.scheduler.skipped.count":      metrics.SplunkSchedulerSkippedCount.Enabled,
+		"splunk.scheduler.lag.seconds":        metrics.SplunkSchedulerLagSeconds.Enabled,
+		"splunk.scheduler.execution.duration": metrics.SplunkSchedulerExecutionDuration.Enabled,
+	}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	// several logged reasons can map to the same value, their skips add up
+	type skip struct{ savedSearch, reason string }
+	var order []skip
+	skips := make(map[skip]int64)
+	for _, row := range rows["splunk.scheduler.skipped.count"] {
+		if !s.savedSearchAllowed(row.attribute) {
+			continue
+		}
+		v, err := strconv.ParseInt(row.value, 10, 64)
 		if err != nil {
 			errs.Add(err)
-			return
+			continue
 		}
-		res.Body.Close()
-
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
+		k := skip{row.attribute, skipReason(row.breakdown)}
+		if _, ok := skips[k]; !ok {
+			order = append(order, k)
 		}
+		skips[k] += v
+	}
+	for _, k := range order {
+		s.mb.RecordSplunkSchedulerSkippedCountDataPoint(now, skips[k], k.savedSearch, k.reason)
+	}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
+	for _, row := range rows["splunk.scheduler.lag.seconds"] {
+		if !s.savedSearchAllowed(row.attribute) {
+			continue
 		}
+		if v, err := strconv.ParseFloat(row.value, 64); err != nil {
+			errs.Add(err)
+		} else {
+			s.mb.RecordSplunkSchedulerLagSecondsDataPoint(now, v, row.attribute)
+		}
+	}
 
-		if time.Since(start) > s.conf.MaxSearchWaitTime {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
+	for _, row := range rows["splunk.scheduler.execution.duration"] {
+		if !s.savedSearchAllowed(row.attribute) {
+			continue
+		}
+		if v, err := strconv.ParseFloat(row.value, 64); err != nil {
+			errs.Add(err)
+		} else {
+			s.mb.RecordSplunkSchedulerExecutionDurationDataPoint(now, v, row.attribute)
 		}
 	}
+}
+
+// Scrape how often the alerts of saved searches fire and get throttled from the scheduler's logs.
+// The counts come from the search shared with scrapeSchedulerMetrics. Searches without any alert
+// action don't fire anything and have neither count, runs that did not fire count as zero
+func (s *instanceScraper) scrapeSavedSearchAlerts(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkSavedsearchAlertFiredCount.Enabled && !metrics.SplunkSavedsearchAlertSuppressedCount.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{
+		"splunk.savedsearch.alert.fired.count":      metrics.SplunkSavedsearchAlertFiredCount.Enabled,
+		"splunk.savedsearch.alert.suppressed.count": metrics.SplunkSavedsearchAlertSuppressedCount.Enabled,
+	}, errs)
 
 	// Record the results
-	var indexName string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "indexname":
-			indexName = f.Value
-			continue
-		case "By":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkLicenseIndexUsageDataPoint(now, int64(v), indexName)
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, row := range rows["splunk.savedsearch.alert.fired.count"] {
+		if !s.savedSearchAllowed(row.attribute) || row.value == "" {
+			continue
+		}
+		if v, err := strconv.ParseInt(row.value, 10, 64); err != nil {
+			errs.Add(err)
+		} else {
+			s.mb.RecordSplunkSavedsearchAlertFiredCountDataPoint(now, v, row.attribute)
+		}
+	}
+
+	for _, row := range rows["splunk.savedsearch.alert.suppressed.count"] {
+		if !s.savedSearchAllowed(row.attribute) || row.value == "" {
+			continue
+		}
+		if v, err := strconv.ParseInt(row.value, 10, 64); err != nil {
+			errs.Add(err)
+		} else {
+			s.mb.RecordSplunkSavedsearchAlertSuppressedCountDataPoint(now, v, row.attribute)
 		}
 	}
 }
 
-// Helper function for unmarshaling search endpoint requests
-func unmarshallSearchReq(res *http.Response, sr *searchResponse) error {
-	sr.Return = res.StatusCode
+// Scrape how many triggered alerts Splunk retains and how old the oldest of them is. Triggered
+// alerts are kept until they expire, so an alert storm shows as a pile of them
+func (s *instanceScraper) scrapeFiredAlerts(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var count int64
+	var oldest float64
 
-	if res.ContentLength == 0 {
-		return nil
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkAlertsTriggeredCount.Enabled && !metrics.SplunkAlertsTriggeredOldestSeconds.Enabled {
+		return
 	}
 
-	body, err := io.ReadAll(res.Body)
+	err := s.getAllPages(ctx, s.api[`SplunkFiredAlerts`], func(body []byte) (paging, int, error) {
+		var fa firedAlerts
+		if err := json.Unmarshal(body, &fa); err != nil {
+			return paging{}, 0, err
+		}
+		for _, entry := range fa.Entries {
+			if !s.appAllowed(entry.ACL.App) {
+				continue
+			}
+			count++
+			if triggered := entry.Content.TriggerTime; triggered.ok && (oldest == 0 || triggered.value < oldest) {
+				oldest = triggered.value
+			}
+		}
+		return fa.Paging, len(fa.Entries), nil
+	})
 	if err != nil {
-		return fmt.Errorf("Failed to read response: %w", err)
+		errs.Add(err)
+		return
 	}
 
-	err = xml.Unmarshal(body, &sr)
-	if err != nil {
-		return fmt.Errorf("Failed to unmarshall response: %w", err)
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	s.mb.RecordSplunkAlertsTriggeredCountDataPoint(now, count)
+
+	// clocks out of step must not make the age negative
+	var age float64
+	if oldest > 0 {
+		age = math.Max(float64(now.AsTime().Unix())-oldest, 0)
 	}
+	s.mb.RecordSplunkAlertsTriggeredOldestSecondsDataPoint(now, age)
+}
 
-	return nil
+// The value of a search based metric and the attribute it is recorded for, as found in one row of
+// the results of its search
+type metricRow struct {
+	value     string
+	attribute string
+	// second attribute of the metrics in searchMetricBreakdowns
+	breakdown string
 }
 
-// Scrape index throughput introspection endpoint
-func (s *splunkScraper) scrapeIndexThroughput(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	var it indexThroughput
-	var ept string
+// Run the searches the enabled search based metrics are computed from and return the rows of each
+// metric. Metrics with a custom search get the rows of their own search while the others get the
+// rows of their built-in search, shared with every other metric computed from it. A failed search
+// only costs the metrics computed from it
+func (s *instanceScraper) searchMetricRows(ctx context.Context, now pcommon.Timestamp, enabled map[string]bool, errs *scrapererror.ScrapeErrors) map[string][]metricRow {
+	rows := make(map[string][]metricRow)
+	builtin := make(map[string][]string)
 
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerThroughput.Enabled {
-		return
-	}
+	for name, on := range enabled {
+		if !on {
+			continue
+		}
+		cs, ok := s.conf.CustomSearches[name]
+		if !ok {
+			key := s.searchKey(name)
+			builtin[key] = append(builtin[key], name)
+			continue
+		}
 
-	ept = apiDict[`SplunkIndexerThroughput`]
+		sr := searchResponse{name: name}
+		if cs.SavedSearchName != "" {
+			sr.savedSearch = cs.SavedSearchName
+		} else {
+			sr.search = customSearchBody(cs.Search)
+		}
+		if err := s.pollSearchJob(ctx, now, &sr); err != nil {
+			errs.Add(fmt.Errorf("custom search for %s: %w", name, err))
+			continue
+		}
 
-	req, err := s.splunkClient.createAPIRequest(ctx, ept)
-	if err != nil {
-		errs.Add(err)
+		field, attribute := s.metricFields(name)
+		if cs.Field != "" {
+			field = cs.Field
+		}
+		rows[name] = metricRowsOf(sr.Results, field, attribute, searchMetricBreakdowns[name])
 	}
 
-	res, err := s.splunkClient.makeRequest(req)
-	if err != nil {
-		errs.Add(err)
-		return
+	for key, names := range builtin {
+		results, err := s.sharedSearch(ctx, now, key)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		for _, name := range names {
+			field, attribute := s.metricFields(name)
+			rows[name] = metricRowsOf(results, field, attribute, searchMetricBreakdowns[name])
+		}
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		errs.Add(err)
+	return rows
+}
+
+// The searchDict entry of the built-in search a search based metric is computed from
+func (s *instanceScraper) searchKey(name string) string {
+	if key, ok := monitoringConsoleSearches[name]; ok && s.conf.UseMonitoringConsole {
+		return key
 	}
+	return searchMetrics[name].search
+}
 
-	err = json.Unmarshal(body, &it)
-	if err != nil {
-		errs.Add(err)
+// The fields of its search's results holding the value and the attribute of a search based
+// metric. Only those of the license usage search can be remapped, for summary indexes naming
+// them differently
+func (s *instanceScraper) metricFields(name string) (field, attribute string) {
+	if name == "splunk.license.index.usage" {
+		return s.conf.LicenseBytesField, s.conf.LicenseIndexField
 	}
+	return searchMetrics[name].field, searchMetrics[name].attribute
+}
 
-	for _, entry := range it.Entries {
-		s.mb.RecordSplunkIndexerThroughputDataPoint(now, 1000*entry.Content.AvgKb, entry.Content.Status)
+// The body of a built-in search, the license usage searches scoped to the indexes of
+// license_usage_indexes by a clause ahead of their first pipe, or right after the tstats of the
+// Monitoring Console one, the ingestion latency search
+// aggregating with the function of ingestion_latency_statistic and the per index search duration
+// search giving up its window of the last 10 minutes for the search time range when one is set
+func (s *instanceScraper) builtinSearch(key string) string {
+	search := searchDict[key]
+	switch key {
+	case `SplunkLicenseIndexUsageSearch`, `SplunkMCLicenseUsageSearch`:
+		if len(s.conf.LicenseUsageIndexes) == 0 {
+			return search
+		}
+		scope := fmt.Sprintf(`idx IN ("%s")`, strings.Join(s.conf.LicenseUsageIndexes, `", "`))
+		if key == `SplunkMCLicenseUsageSearch` {
+			// idx is no indexed field tstats could filter on, the rows are picked once it is a field
+			return strings.Replace(search, "| eval indexname", "| search "+scope+"| eval indexname", 1)
+		}
+		base, rest, _ := strings.Cut(search, "|")
+		return fmt.Sprintf(`%s %s|%s`, base, scope, rest)
+	case `SplunkIngestionLatencySearch`:
+		if s.conf.IngestionLatencyStatistic == "" {
+			return search
+		}
+		return strings.Replace(search, "avg(latency)", s.conf.IngestionLatencyStatistic+"(latency)", 1)
+	case `SplunkIndexSearchDurationSearch`:
+		// all time would average every search ever audited, so the window stays unless replaced
+		if s.conf.SearchEarliestTime == "" && s.conf.SearchLatestTime == "" {
+			return search
+		}
+		return strings.Replace(search, " earliest=-10m@m latest=@m", "", 1)
+	}
+	return search
+}
+
+// Return the results of the built-in search, dispatching it unless another scrape function
+// already did during this scrape. Every caller gets the error of a failed search since it costs
+// each of them their metrics
+func (s *instanceScraper) sharedSearch(ctx context.Context, now pcommon.Timestamp, key string) ([]searchResult, error) {
+	s.searchesMux.Lock()
+	if s.searches == nil {
+		s.searches = make(map[string]*sharedSearch)
+	}
+	ss, ok := s.searches[key]
+	if !ok {
+		ss = &sharedSearch{}
+		s.searches[key] = ss
 	}
+	s.searchesMux.Unlock()
+
+	ss.once.Do(func() {
+		sr := searchResponse{
+			name:   key,
+			search: s.builtinSearch(key),
+		}
+		ss.err = s.pollSearchJob(ctx, now, &sr)
+		ss.results = sr.Results
+	})
+
+	return ss.results, ss.err
+}
+
+// Pick the value and attribute fields out of every row of a search's results, along with the
+// breakdown field when the metric has one
+func metricRowsOf(results []searchResult, field, attribute, breakdown string) []metricRow {
+	rows := make([]metricRow, 0, len(results))
+	for _, r := range results {
+		if nonFinite(r.value(field)) {
+			continue
+		}
+		row := metricRow{value: r.value(field), attribute: r.value(attribute)}
+		if breakdown != "" {
+			row.breakdown = r.value(breakdown)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// Whether a value of search results is NaN or infinite, e.g. an average over no events at all.
+// Such values say nothing and break whatever consumes the datapoint, so the row is dropped
+func nonFinite(value string) bool {
+	v, err := strconv.ParseFloat(value, 64)
+	return err == nil && (math.IsNaN(v) || math.IsInf(v, 0))
+}
+
+// Form encode a custom search for dispatch. Like the search bar we run it through the search
+// command unless it starts with a generating command of its own
+func customSearchBody(spl string) string {
+	spl = strings.TrimSpace(spl)
+	if !strings.HasPrefix(spl, "|") && !strings.HasPrefix(spl, "search ") {
+		spl = "search " + spl
+	}
+	return url.Values{"search": {spl}}.Encode()
+}
+
+// Whether the per saved search metrics should be recorded for the named saved search
+func (s *splunkScraper) savedSearchAllowed(name string) bool {
+	return len(s.savedSearches) == 0 || s.savedSearches[name]
+}
+
+// Whether splunk.sourcetype.event.count should be recorded for the named source type
+func (s *splunkScraper) sourcetypeAllowed(name string) bool {
+	return len(s.sourcetypes) == 0 || s.sourcetypes[name]
+}
+
+// Whether splunk.search.count should be recorded for the named app
+func (s *splunkScraper) appAllowed(name string) bool {
+	return len(s.apps) == 0 || s.apps[name]
+}
+
+// Whether the metrics of the index list should be recorded for the named index
+func (s *splunkScraper) indexAllowed(name string) bool {
+	if s.indexInclude != nil && !s.indexInclude.MatchString(name) {
+		return false
+	}
+	return s.indexExclude == nil || !s.indexExclude.MatchString(name)
+}
+
+// Whether the splunk.user.dispatch.quota metrics should be recorded for the named user
+func (s *splunkScraper) userAllowed(name string) bool {
+	return len(s.users) == 0 || s.users[name]
+}
+
+// Dispatches the search held in sr and polls the job until its results are ready, at which
+// point they are unmarshalled into sr. Polls back off exponentially and the whole exchange is
+// bounded by MaxSearchWaitTime. A dispatch turned down with a 4xx fails right away while any other
+// failed dispatch is tried again after the same backoff as polls. The job is deleted on the way
+// out whether or not it finished.
+// Every search based scrape function should go through here
+func (s *instanceScraper) pollSearchJob(ctx context.Context, now pcommon.Timestamp, sr *searchResponse) error {
+	var err error
+
+	defer func() {
+		if sr.Jobid == nil {
+			return
+		}
+		// failing to clean up shouldn't cost us the metric, but an operator should know about it.
+		// Once the scrape is cancelled there is no way to clean up, the job will expire on its own
+		if err := s.splunkClient.deleteSearchJob(ctx, *sr.Jobid); err != nil && ctx.Err() == nil {
+			s.settings.Logger.Warn("Failed to clean up search job", zap.String("sid", *sr.Jobid), zap.Error(err))
+		}
+	}()
+
+	start := time.Now()
+	backoff := newSearchBackoff(s.conf.SearchPollInterval, s.conf.MaxSearchPollInterval)
+
+	for {
+		dispatch := sr.Jobid == nil
+		err = s.requestSearch(ctx, sr)
+
+		// a mangled 200, e.g. results truncated by a proxy, says nothing about the job itself so
+		// we skip it and ask for the results again
+		corrupt := errors.Is(err, errCorruptSearchResponse) && sr.Return == 200
+		if err != nil && !corrupt {
+			return err
+		}
+
+		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
+		// the 200 is coming after the first request which provides a jobId to retrieve results.
+		// A full page of results may be followed by more, which we ask for right away. Past the
+		// first page the job is done, no content there means no rows are left
+		fullPage := sr.Return == 200 && len(sr.Results)-sr.offset == searchResultsPageSize
+		switch {
+		case !corrupt && sr.Jobid != nil && fullPage:
+			sr.offset = len(sr.Results)
+		case !corrupt && sr.Jobid != nil && (sr.Return == 200 || (sr.Return == 204 && sr.offset > 0)):
+			s.recordSearchWait(now, sr, time.Since(start))
+			s.scrapeSearchJobStats(ctx, now, sr)
+			return nil
+		}
+
+		// a job that failed or got paused never gets past a 204, there is no point waiting on it
+		if sr.Return == 204 && sr.Jobid != nil {
+			if err = s.checkSearchJob(ctx, sr); err != nil {
+				return err
+			}
+		}
+
+		remaining := s.conf.MaxSearchWaitTime - time.Since(start)
+		if remaining <= 0 {
+			s.countSearchTimeout(sr)
+			if corrupt {
+				return err
+			}
+			return errMaxSearchWaitTimeExceeded
+		}
+
+		// the next page of results and the first poll of a job just dispatched are asked for right
+		// away, everything else waits its turn, a failed dispatch included
+		if !(fullPage && !corrupt) && !(dispatch && sr.Jobid != nil) {
+			wait := backoff.next()
+			if wait > remaining {
+				wait = remaining
+			}
+			// stop waiting on the job as soon as the scrape is cancelled, e.g. on shutdown
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+	}
+}
+
+// Send the next request of the search held in sr, dispatching it or asking for its results, and read
+// the response into sr. Asking for results is bounded by ResultsReadTimeout as a large page can take
+// long to read even though the job is done
+func (s *instanceScraper) requestSearch(ctx context.Context, sr *searchResponse) error {
+	reqCtx := ctx
+	results := sr.Jobid != nil
+	if results && s.conf.ResultsReadTimeout > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, s.conf.ResultsReadTimeout)
+		defer cancel()
+	}
+
+	req, err := s.splunkClient.createRequest(reqCtx, sr)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err == nil {
+		// if its a 204 the body will be empty because we are still waiting on search results
+		err = s.unmarshallSearchReq(res, sr)
+		res.Body.Close()
+	}
+
+	// a dispatch that failed on a connection error or a 5xx may have created the job all the same,
+	// in which case a retry under the same SID is turned down for reusing it while the job is ours.
+	// It is looked for once per dispatch and only then, a dispatch answered right away by a 4xx
+	// never created one
+	if !results && sr.Jobid == nil && sr.sid != "" && attemptFailed(req) && ctx.Err() == nil &&
+		s.searchJobExists(ctx, sr.sid) {
+		sid := sr.sid
+		sr.Jobid = &sid
+		return nil
+	}
+
+	// the search head won't take the search as it is, e.g. a syntax error in custom SPL, which
+	// dispatching it again won't change
+	if !results && sr.Jobid == nil && sr.Return >= http.StatusBadRequest && sr.Return < http.StatusInternalServerError {
+		return fmt.Errorf("%w for search %s: %s: %s", errSearchDispatchRejected, sr.name, http.StatusText(sr.Return),
+			searchMessages(sr.Messages))
+	}
+
+	if results && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("%w for search %s: %w", errResultsReadTimeout, sr.name, err)
+	}
+	return err
+}
+
+// Whether the deployment holds a search job with the given SID
+func (s *instanceScraper) searchJobExists(ctx context.Context, sid string) bool {
+	var job searchJob
+	return s.getAPI(ctx, s.splunkClient.jobsPath+sid+"?output_mode=json", &job) == nil
+}
+
+// Look up the dispatch state of a running search job and fail if it is never going to finish. Failing
+// to look it up is left to MaxSearchWaitTime
+func (s *instanceScraper) checkSearchJob(ctx context.Context, sr *searchResponse) error {
+	var job searchJob
+
+	ept := s.splunkClient.jobsPath + *sr.Jobid + "?output_mode=json"
+	if err := s.getAPI(ctx, ept, &job); err != nil {
+		s.settings.Logger.Debug("Failed to get search job status", zap.String("search", sr.name), zap.Error(err))
+		return nil
+	}
+
+	for _, entry := range job.Entries {
+		state := entry.Content.DispatchState
+		if !entry.Content.IsFailed && !failedDispatchStates[state] {
+			continue
+		}
+
+		return fmt.Errorf("%w: job %s of search %s is %s: %s", errSearchJobFailed, *sr.Jobid, sr.name, state,
+			searchMessages(entry.Content.Messages))
+	}
+
+	return nil
+}
+
+// The messages the search head gave along with a search or its job, one after the other
+func searchMessages(msgs []searchJobMessage) string {
+	messages := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		messages = append(messages, m.Type+": "+strings.TrimSpace(m.Text))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Record how long we waited on a search job to finish, which is what MaxSearchWaitTime bounds
+func (s *instanceScraper) recordSearchWait(now pcommon.Timestamp, sr *searchResponse, wait time.Duration) {
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	s.mb.RecordSplunkReceiverSearchWaitSecondsDataPoint(now, wait.Seconds(), sr.name)
+}
+
+// Count a search giving up on MaxSearchWaitTime. The counts are recorded once every scrape
+// function has returned so that searches keep reporting them in scrapes they made it in time
+func (s *instanceScraper) countSearchTimeout(sr *searchResponse) {
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	if s.searchTimeouts == nil {
+		s.searchTimeouts = make(map[string]int64)
+	}
+	s.searchTimeouts[sr.name]++
+}
+
+// Record how much work a finished search job did. These describe the receiver's own searches, so
+// failing to get them is only logged and never costs us the metric the search was run for
+func (s *instanceScraper) scrapeSearchJobStats(ctx context.Context, now pcommon.Timestamp, sr *searchResponse) {
+	var job searchJob
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkSearchScanCount.Enabled && !metrics.SplunkSearchEventCount.Enabled &&
+		!metrics.SplunkSearchRunDurationSeconds.Enabled {
+		return
+	}
+
+	ept := s.splunkClient.jobsPath + *sr.Jobid + "?output_mode=json"
+	if err := s.getAPI(ctx, ept, &job); err != nil {
+		s.settings.Logger.Debug("Failed to get search job statistics", zap.String("search", sr.name), zap.Error(err))
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range job.Entries {
+		s.mb.RecordSplunkSearchScanCountDataPoint(now, int64(entry.Content.ScanCount), sr.name)
+		s.mb.RecordSplunkSearchEventCountDataPoint(now, int64(entry.Content.EventCount), sr.name)
+		s.mb.RecordSplunkSearchRunDurationSecondsDataPoint(now, entry.Content.RunDuration, sr.name)
+	}
+}
+
+// Produces the waits between polls of a running search job. The interval doubles after every
+// poll up to max so quick searches return fast without hammering the search head on slow ones
+type searchBackoff struct {
+	interval time.Duration
+	max      time.Duration
+}
+
+func newSearchBackoff(initial, max time.Duration) *searchBackoff {
+	if initial > max {
+		initial = max
+	}
+	return &searchBackoff{
+		interval: initial,
+		max:      max,
+	}
+}
+
+// Returns how long to wait before the next poll. Up to half of the current interval is
+// shaved off at random so concurrent searches don't poll in lockstep
+func (b *searchBackoff) next() time.Duration {
+	wait := b.interval - time.Duration(rand.Int63n(int64(b.interval)/2+1))
+
+	b.interval *= 2
+	if b.interval > b.max {
+		b.interval = b.max
+	}
+
+	return wait
+}
+
+// Helper function for unmarshaling search endpoint requests
+func (s *splunkScraper) unmarshallSearchReq(res *http.Response, sr *searchResponse) error {
+	sr.Return = res.StatusCode
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("Failed to read response: %w", err)
+	}
+
+	// chunked responses, and those a proxy or gunzipping passed on, have no Content-Length to
+	// tell an empty body by, so only reading it does
+	if len(body) == 0 {
+		return nil
+	}
+
+	// rows are appended to those of the pages read before, drop whatever a previous attempt at
+	// this page left behind
+	sr.Results = sr.Results[:sr.offset]
+	sr.Messages = nil
+
+	if s.conf.SearchOutputMode == searchOutputModeXML {
+		err = xml.Unmarshal(body, &sr)
+	} else {
+		err = unmarshallSearchReqJSON(body, sr)
+	}
+	if err != nil {
+		s.settings.Logger.Debug("Malformed search response", zap.String("search", sr.name),
+			zap.Int("status", res.StatusCode), zap.ByteString("body", body))
+		return fmt.Errorf("%w for search %s: %w", errCorruptSearchResponse, sr.name, err)
+	}
+
+	return nil
+}
+
+// Reads a search response written with output_mode=json, appending its rows to those of the
+// pages read before the way the XML path does
+func unmarshallSearchReqJSON(body []byte, sr *searchResponse) error {
+	var res searchResponseJSON
+	if err := json.Unmarshal(body, &res); err != nil {
+		return err
+	}
+
+	if res.Jobid != nil {
+		sr.Jobid = res.Jobid
+	}
+	sr.Results = append(sr.Results, res.Results...)
+	sr.Messages = res.Messages
+	return nil
+}
+
+// Scrape index throughput introspection endpoint
+func (s *instanceScraper) scrapeIndexThroughput(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var it indexThroughput
+	var ept string
+
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerThroughput.Enabled {
+		return
+	}
+
+	ept = s.api[`SplunkIndexerThroughput`]
+
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+	}
+
+	err = json.Unmarshal(body, &it)
+	if err != nil {
+		errs.Add(err)
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range it.Entries {
+		if entry.Content.AvgKb.ok {
+			s.mb.RecordSplunkIndexerThroughputDataPoint(now, 1000*entry.Content.AvgKb.value, entry.Content.Status)
+		}
+	}
+}
+
+// Scrape indexer throughput per source type from the indexers' metrics.log, see idxTContent
+func (s *instanceScraper) scrapeSourcetypeThroughput(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerThroughputBySourcetype.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{"splunk.indexer.throughput.by_sourcetype": true}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, row := range rows["splunk.indexer.throughput.by_sourcetype"] {
+		v, err := strconv.ParseFloat(row.value, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkIndexerThroughputBySourcetypeDataPoint(now, v, row.attribute)
+	}
+}
+
+// Scrape the number of events indexed by source type over the configured search time range. The
+// count is read from the index metadata by tstats, which spares us searching the events themselves
+func (s *instanceScraper) scrapeSourcetypeVolume(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSourcetypeEventCount.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{"splunk.sourcetype.event.count": true}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, row := range rows["splunk.sourcetype.event.count"] {
+		if !s.sourcetypeAllowed(row.attribute) {
+			continue
+		}
+		v, err := strconv.ParseInt(row.value, 10, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkSourcetypeEventCountDataPoint(now, v, row.attribute)
+	}
+}
+
+// Scrape how long events of every source type indexed over the last 10 minutes took to get
+// indexed. tstats reads the index time of the last event indexed for every second of event
+// time, events older than a day are not looked for
+func (s *instanceScraper) scrapeIngestionLatency(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerIngestionLatencySeconds.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{"splunk.indexer.ingestion.latency.seconds": true}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, row := range rows["splunk.indexer.ingestion.latency.seconds"] {
+		if !s.sourcetypeAllowed(row.attribute) {
+			continue
+		}
+		v, err := strconv.ParseFloat(row.value, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkIndexerIngestionLatencySecondsDataPoint(now, v, row.attribute)
+	}
+}
+
+// Scrape how many events every index wrote and had searches scan per second over the last 10
+// minutes. Both are counted by searches over that window rather than read from counters kept by
+// Splunk, so they are recorded as rates over the window instead of running totals
+func (s *instanceScraper) scrapeIndexEventRates(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkIndexEventsWrittenRate.Enabled && !metrics.SplunkIndexEventsSearchedRate.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{
+		"splunk.index.events.written.rate":  metrics.SplunkIndexEventsWrittenRate.Enabled,
+		"splunk.index.events.searched.rate": metrics.SplunkIndexEventsSearchedRate.Enabled,
+	}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	record := map[string]func(pcommon.Timestamp, float64, string){
+		"splunk.index.events.written.rate":  s.mb.RecordSplunkIndexEventsWrittenRateDataPoint,
+		"splunk.index.events.searched.rate": s.mb.RecordSplunkIndexEventsSearchedRateDataPoint,
+	}
+	for name, recordDataPoint := range record {
+		for _, row := range rows[name] {
+			v, err := strconv.ParseFloat(row.value, 64)
+			if err != nil {
+				errs.Add(err)
+				continue
+			}
+			recordDataPoint(now, v, row.attribute)
+		}
+	}
+}
+
+// Scrape how long the searches touching every index took to run on average, from the searches
+// completed in _audit over the search time range. A search is attributed to the indexes named
+// in its index= terms, so one naming several indexes counts against each of them
+func (s *instanceScraper) scrapeIndexSearchDuration(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexSearchDurationSeconds.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{"splunk.index.search.duration.seconds": true}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, row := range rows["splunk.index.search.duration.seconds"] {
+		v, err := strconv.ParseFloat(row.value, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkIndexSearchDurationSecondsDataPoint(now, v, row.attribute)
+	}
+}
+
+// Scrape how many searches ran in every app over the last 10 minutes from the resource usage
+// introspection data, which tags the process of every search with the app it was run from
+func (s *instanceScraper) scrapeSearchActivityByApp(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSearchCount.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{"splunk.search.count": true}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, row := range rows["splunk.search.count"] {
+		if !s.appAllowed(row.attribute) {
+			continue
+		}
+		v, err := strconv.ParseInt(row.value, 10, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkSearchCountDataPoint(now, v, row.attribute)
+	}
+}
+
+// Scrape the fill ratio of the indexer pipeline queues from the queues introspection endpoint,
+// and how long the data sitting in each of them is going to wait before leaving it
+func (s *instanceScraper) scrapeIndexerQueues(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var iq indexerQueues
+	var it indexThroughput
+	var ept string
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	latency := metrics.SplunkIndexerQueueLatencySeconds.Enabled
+	if !metrics.SplunkIndexerQueueRatio.Enabled && !latency {
+		return
+	}
+
+	// queues don't report their dequeue rate. Every event goes through each of the pipeline
+	// queues on its way to disk, so in a steady state every queue drains at the rate the
+	// indexer writes data
+	if latency {
+		if err := s.getAPI(ctx, s.api[`SplunkIndexerThroughput`], &it); err != nil {
+			errs.Add(err)
+			latency = false
+		}
+	}
+	var bytesPerSecond float64
+	for _, entry := range it.Entries {
+		bytesPerSecond += 1000 * entry.Content.AvgKb.value
+	}
+
+	ept = s.api[`SplunkIndexerQueueRatio`]
+
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	err = json.Unmarshal(body, &iq)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range iq.Entries {
+		if !pipelineQueues[strings.ToLower(entry.Name)] {
+			continue
+		}
+		// a queue without a maximum size has no meaningful fill ratio
+		if entry.Content.MaxSizeBytes != 0 {
+			s.mb.RecordSplunkIndexerQueueRatioDataPoint(now, entry.Content.CurrentSizeBytes/entry.Content.MaxSizeBytes, entry.Name)
+		}
+
+		// an approximation: latency = current_size_bytes / indexer throughput in bytes per second,
+		// i.e. how long the queue takes to drain at the current rate if nothing else came in.
+		// An idle indexer drains nothing, so there is no latency to speak of
+		if latency && bytesPerSecond > 0 {
+			s.mb.RecordSplunkIndexerQueueLatencySecondsDataPoint(now, entry.Content.CurrentSizeBytes/bytesPerSecond, entry.Name)
+		}
+	}
+}
+
+// Scrape the health of the KV store
+func (s *instanceScraper) scrapeKVStoreStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var kv kvStoreStatus
+	var ept string
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkKvstoreStatus.Enabled && !metrics.SplunkKvstoreReplicationStatus.Enabled &&
+		!metrics.SplunkKvstoreBackupRestoreStatus.Enabled {
+		return
+	}
+
+	ept = s.api[`SplunkKVStoreStatus`]
+
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	err = json.Unmarshal(body, &kv)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range kv.Entries {
+		current := entry.Content.Current
+
+		if current.Status != "" {
+			s.mb.RecordSplunkKvstoreStatusDataPoint(now, kvStoreHealth(current.Status, "ready"), current.Status)
+		}
+		if current.ReplicationStatus != "" {
+			s.mb.RecordSplunkKvstoreReplicationStatusDataPoint(now,
+				kvStoreHealth(current.ReplicationStatus, "kv store captain", "non-captain kv store member"), current.ReplicationStatus)
+		}
+		if current.BackupRestoreStatus != "" {
+			s.mb.RecordSplunkKvstoreBackupRestoreStatusDataPoint(now, kvStoreHealth(current.BackupRestoreStatus, "ready"), current.BackupRestoreStatus)
+		}
+	}
+}
+
+// Scrape the size and number of records of every KV store collection, by the app owning it
+func (s *instanceScraper) scrapeKVStoreCollections(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var stats []kvCollectionStat
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkKvstoreCollectionSizeBytes.Enabled && !metrics.SplunkKvstoreCollectionCount.Enabled {
+		return
+	}
+
+	err := s.getAllPages(ctx, s.api[`SplunkKVStoreCollections`], func(body []byte) (paging, int, error) {
+		var kc kvCollectionStats
+		if err := json.Unmarshal(body, &kc); err != nil {
+			return paging{}, 0, err
+		}
+		for _, entry := range kc.Entries {
+			stats = append(stats, entry.Content.Data...)
+		}
+		return kc.Paging, len(kc.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, stat := range stats {
+		app, collection, ok := strings.Cut(stat.NS, ".")
+		if !ok {
+			continue
+		}
+		if stat.Size.ok {
+			s.mb.RecordSplunkKvstoreCollectionSizeBytesDataPoint(now, int64(stat.Size.value), collection, app)
+		}
+		if stat.Count.ok {
+			s.mb.RecordSplunkKvstoreCollectionCountDataPoint(now, int64(stat.Count.value), collection, app)
+		}
+	}
+}
+
+// Returns 1 if the reported KV store status is one of the healthy ones, 0 otherwise
+func kvStoreHealth(status string, healthy ...string) int64 {
+	for _, h := range healthy {
+		if strings.EqualFold(status, h) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Scrape bucket counts and sizes of every index allowed by index_include_regex and
+// index_exclude_regex. The number of indexes of each type counts every index all the same
+func (s *instanceScraper) scrapeIndexesExtended(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var entries []idxEEntry
+	var ept string
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkIndexBucketCount.Enabled && !metrics.SplunkIndexRawSizeBytes.Enabled &&
+		!metrics.SplunkIndexEventCount.Enabled && !metrics.SplunkIndexEarliestEventSeconds.Enabled &&
+		!metrics.SplunkIndexLatestEventSeconds.Enabled && !metrics.SplunkIndexHotBucketsCount.Enabled &&
+		!metrics.SplunkIndexHotBucketsMax.Enabled && !metrics.SplunkIndexesCount.Enabled &&
+		!metrics.SplunkIndexFrozenTimeSeconds.Enabled && !metrics.SplunkIndexMaxSizeBytes.Enabled &&
+		!metrics.SplunkIndexThawedSizeBytes.Enabled {
+		return
+	}
+
+	ept = s.api[`SplunkIndexesExtended`]
+
+	err := s.getAllPages(ctx, ept, func(body []byte) (paging, int, error) {
+		var ie indexesExtended
+		if err := json.Unmarshal(body, &ie); err != nil {
+			return paging{}, 0, err
+		}
+		entries = append(entries, ie.Entries...)
+		return ie.Paging, len(ie.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	// both types are always reported so that the count of a type dropping to none shows
+	indexTypes := map[string]int64{"event": 0, "metric": 0}
+	for _, entry := range entries {
+		// indexes predating metrics indexes report no datatype and hold events
+		if entry.Content.Datatype == "" {
+			indexTypes["event"]++
+		} else {
+			indexTypes[entry.Content.Datatype]++
+		}
+		if !s.indexAllowed(entry.Name) {
+			continue
+		}
+
+		s.mb.RecordSplunkIndexBucketCountDataPoint(now, int64(entry.Content.TotalBucketCount), entry.Name)
+		s.mb.RecordSplunkIndexRawSizeBytesDataPoint(now, int64(entry.Content.TotalRawSizeMB*1024*1024), entry.Name)
+		s.mb.RecordSplunkIndexEventCountDataPoint(now, int64(entry.Content.TotalEventCount), entry.Name)
+		// an index without any events has no event times to report
+		if entry.Content.MinTime.ok {
+			s.mb.RecordSplunkIndexEarliestEventSecondsDataPoint(now, entry.Content.MinTime.value.Unix(), entry.Name)
+		}
+		if entry.Content.MaxTime.ok {
+			s.mb.RecordSplunkIndexLatestEventSecondsDataPoint(now, entry.Content.MaxTime.value.Unix(), entry.Name)
+		}
+		if hot := entry.Content.BucketDirs.Home.HotBucketCount; hot.ok {
+			s.mb.RecordSplunkIndexHotBucketsCountDataPoint(now, int64(hot.value), entry.Name)
+		}
+		if max := entry.Content.MaxHotBuckets; max.ok {
+			s.mb.RecordSplunkIndexHotBucketsMaxDataPoint(now, int64(max.value), entry.Name)
+		}
+		if frozen := entry.Content.FrozenTimePeriodInSecs; frozen.ok {
+			s.mb.RecordSplunkIndexFrozenTimeSecondsDataPoint(now, int64(frozen.value), entry.Name)
+		}
+		if size := entry.Content.MaxTotalDataSizeMB; size.ok {
+			s.mb.RecordSplunkIndexMaxSizeBytesDataPoint(now, int64(size.value*1024*1024), entry.Name)
+		}
+		// nothing ever thawed is no disk used rather than nothing to report, which the zero value
+		// of a missing size stands for
+		s.mb.RecordSplunkIndexThawedSizeBytesDataPoint(now, int64(entry.Content.BucketDirs.Thawed.Size.value*1024*1024), entry.Name)
+	}
+
+	for indexType, count := range indexTypes {
+		s.mb.RecordSplunkIndexesCountDataPoint(now, count, indexType)
+	}
+}
+
+// Scrape how many buckets of every index rolled from hot to warm and got frozen recently, a sign of
+// retention settings at odds with the ingest of the index when it picks up
+func (s *instanceScraper) scrapeBucketEvents(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkIndexBucketsRolledCount.Enabled && !metrics.SplunkIndexBucketsFrozenCount.Enabled {
+		return
+	}
+
+	// rows that fail to parse only cost us their own index
+	events, err := s.bucketEvents.bucketEvents(ctx, now)
+	if err != nil {
+		errs.Add(err)
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for name, e := range events {
+		s.mb.RecordSplunkIndexBucketsRolledCountDataPoint(now, e.rolled, name)
+		s.mb.RecordSplunkIndexBucketsFrozenCountDataPoint(now, e.frozen, name)
+	}
+}
+
+// Scrape how many deployment clients phone home to the deployment server, overall and per server class
+func (s *instanceScraper) scrapeDeploymentServer(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var entries []dcEntry
+	var ept string
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkDeploymentClientsCount.Enabled && !metrics.SplunkDeploymentServerclassClients.Enabled {
+		return
+	}
+
+	ept = s.api[`SplunkDeploymentClients`]
+
+	err := s.getAllPages(ctx, ept, func(body []byte) (paging, int, error) {
+		var dc deploymentClients
+		if err := json.Unmarshal(body, &dc); err != nil {
+			return paging{}, 0, err
+		}
+		entries = append(entries, dc.Entries...)
+		return dc.Paging, len(dc.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	serverClasses := make(map[string]int64)
+	for _, entry := range entries {
+		for name := range entry.Content.ServerClasses {
+			serverClasses[name]++
+		}
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	s.mb.RecordSplunkDeploymentClientsCountDataPoint(now, int64(len(entries)))
+	for name, clients := range serverClasses {
+		s.mb.RecordSplunkDeploymentServerclassClientsDataPoint(now, clients, name)
+	}
+}
+
+// Scrape CPU and memory usage of the host and of the Splunk processes running on it
+func (s *instanceScraper) scrapeServerIntrospection(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var hw hostwideUsage
+	var pu processUsage
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	hostwide := metrics.SplunkServerCPUUsagePercent.Enabled || metrics.SplunkServerMemoryUsageBytes.Enabled
+	processes := metrics.SplunkProcessCPUPercent.Enabled || metrics.SplunkProcessMemoryBytes.Enabled
+	if !hostwide && !processes {
+		return
+	}
+
+	if hostwide {
+		if err := s.getAPI(ctx, s.api[`SplunkHostwideUsage`], &hw); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	if processes {
+		if err := s.getAPI(ctx, s.api[`SplunkProcessUsage`], &pu); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	// a process name is shared by many processes, e.g. one per running search
+	type usage struct {
+		cpu float64
+		mem float64
+	}
+	byProcess := make(map[string]*usage)
+	for _, entry := range pu.Entries {
+		if entry.Content.Process == "" {
+			continue
+		}
+		u, ok := byProcess[entry.Content.Process]
+		if !ok {
+			u = &usage{}
+			byProcess[entry.Content.Process] = u
+		}
+		u.cpu += entry.Content.PctCPU.value
+		u.mem += entry.Content.MemUsedMB.value
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range hw.Entries {
+		c := entry.Content
+		if c.CPUSystemPct.ok || c.CPUUserPct.ok {
+			s.mb.RecordSplunkServerCPUUsagePercentDataPoint(now, c.CPUSystemPct.value+c.CPUUserPct.value)
+		}
+		if c.MemUsedMB.ok {
+			s.mb.RecordSplunkServerMemoryUsageBytesDataPoint(now, int64(c.MemUsedMB.value*1024*1024))
+		}
+	}
+
+	for name, u := range byProcess {
+		s.mb.RecordSplunkProcessCPUPercentDataPoint(now, u.cpu, name)
+		s.mb.RecordSplunkProcessMemoryBytesDataPoint(now, int64(u.mem*1024*1024), name)
+	}
+}
+
+// Scrape the space used and left on every partition Splunk writes to, its indexes included
+func (s *instanceScraper) scrapeDiskUsage(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var ps partitionsSpace
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkServerPartitionUsedBytes.Enabled && !metrics.SplunkServerPartitionFreeBytes.Enabled &&
+		!metrics.SplunkServerPartitionCapacityBytes.Enabled {
+		return
+	}
+
+	if err := s.getAPI(ctx, s.api[`SplunkPartitionsSpace`], &ps); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range ps.Entries {
+		c := entry.Content
+		// pseudo filesystems report no capacity, there is nothing to run out of on them
+		if !c.Capacity.ok || c.Capacity.value <= 0 || !c.Free.ok {
+			continue
+		}
+
+		capacity, free := int64(c.Capacity.value*1024*1024), int64(c.Free.value*1024*1024)
+		s.mb.RecordSplunkServerPartitionCapacityBytesDataPoint(now, capacity, c.MountPoint)
+		s.mb.RecordSplunkServerPartitionFreeBytesDataPoint(now, free, c.MountPoint)
+		s.mb.RecordSplunkServerPartitionUsedBytesDataPoint(now, capacity-free, c.MountPoint)
+	}
+}
+
+// Scrape replication health of every index and the state of every peer from the manager of an
+// indexer cluster. Any other instance answers the cluster manager endpoints with a 404 or a 403,
+// in which case nothing is recorded
+func (s *instanceScraper) scrapeClusterMaster(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var entries []ciEntry
+	var peers []cpEntry
+	var generation clusterGeneration
+	var config clusterConfig
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	indexes := metrics.SplunkClusterIndexSearchable.Enabled || metrics.SplunkClusterIndexReplicatedCopies.Enabled ||
+		metrics.SplunkClusterFixupPendingCount.Enabled
+	factors := metrics.SplunkClusterReplicationFactor.Enabled || metrics.SplunkClusterSearchFactor.Enabled
+	peerMetrics := metrics.SplunkClusterPeerFixupTasks.Enabled || metrics.SplunkClusterPeerStatus.Enabled ||
+		metrics.SplunkClusterPeerReplicationQueueLength.Enabled
+	if !indexes && !factors && !peerMetrics &&
+		!metrics.SplunkClusterReplicationFactorMet.Enabled && !metrics.SplunkClusterSearchFactorMet.Enabled {
+		return
+	}
+
+	// the generation is only served by the cluster manager, which makes it a cheap role check
+	err := s.getAPI(ctx, s.api[`SplunkClusterGeneration`], &generation)
+	if errors.Is(err, errNotFound) || errors.Is(err, errForbidden) {
+		return
+	}
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	if indexes {
+		err = s.getAllPages(ctx, s.api[`SplunkClusterIndexes`], func(body []byte) (paging, int, error) {
+			var ci clusterIndexes
+			if err := json.Unmarshal(body, &ci); err != nil {
+				return paging{}, 0, err
+			}
+			entries = append(entries, ci.Entries...)
+			return ci.Paging, len(ci.Entries), nil
+		})
+		if err != nil {
+			errs.Add(err)
+			entries = nil
+		}
+	}
+
+	if peerMetrics {
+		err = s.getAllPages(ctx, s.api[`SplunkClusterPeers`], func(body []byte) (paging, int, error) {
+			var cp clusterPeers
+			if err := json.Unmarshal(body, &cp); err != nil {
+				return paging{}, 0, err
+			}
+			peers = append(peers, cp.Entries...)
+			return cp.Paging, len(cp.Entries), nil
+		})
+		if err != nil {
+			errs.Add(err)
+			peers = nil
+		}
+	}
+
+	if factors {
+		if err = s.getAPI(ctx, s.api[`SplunkClusterConfig`], &config); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range generation.Entries {
+		if met := entry.Content.ReplicationFactorMet; met.ok {
+			s.mb.RecordSplunkClusterReplicationFactorMetDataPoint(now, int64(met.value))
+		}
+		if met := entry.Content.SearchFactorMet; met.ok {
+			s.mb.RecordSplunkClusterSearchFactorMetDataPoint(now, int64(met.value))
+		}
+	}
+
+	for _, entry := range config.Entries {
+		if rf := entry.Content.ReplicationFactor; rf.ok {
+			s.mb.RecordSplunkClusterReplicationFactorDataPoint(now, int64(rf.value))
+		}
+		if sf := entry.Content.SearchFactor; sf.ok {
+			s.mb.RecordSplunkClusterSearchFactorDataPoint(now, int64(sf.value))
+		}
+	}
+
+	for _, peer := range peers {
+		if peer.Content.PendingJobCount.ok {
+			s.mb.RecordSplunkClusterPeerFixupTasksDataPoint(now, int64(peer.Content.PendingJobCount.value), peer.Name, peer.Content.Label)
+		}
+		if peer.Content.ReplicationCount.ok {
+			s.mb.RecordSplunkClusterPeerReplicationQueueLengthDataPoint(now, int64(peer.Content.ReplicationCount.value), peer.Name, peer.Content.Label)
+		}
+
+		// a peer that is up still serves no searches until its buckets become searchable, e.g.
+		// while it is being added to the cluster
+		var healthy int64
+		if peer.Content.Status == "Up" && peer.Content.IsSearchable.value == 1 {
+			healthy = 1
+		}
+		if peer.Content.Status != "" {
+			s.mb.RecordSplunkClusterPeerStatusDataPoint(now, healthy, peer.Name, peer.Content.Label, peer.Content.Status)
+		}
+	}
+
+	for _, entry := range entries {
+		if entry.Content.IsSearchable.ok {
+			s.mb.RecordSplunkClusterIndexSearchableDataPoint(now, int64(entry.Content.IsSearchable.value), entry.Name)
+		}
+
+		// a copy is complete once every bucket has it, whatever is missing is waiting on fixup
+		var complete, pending int64
+		for _, slot := range entry.Content.ReplicatedCopiesTracker {
+			actual, expected := slot.ActualCopiesPerSlot.value, slot.ExpectedTotalPerSlot.value
+			if actual >= expected {
+				complete++
+				continue
+			}
+			pending += int64(expected - actual)
+		}
+		s.mb.RecordSplunkClusterIndexReplicatedCopiesDataPoint(now, complete, entry.Name)
+		s.mb.RecordSplunkClusterFixupPendingCountDataPoint(now, pending, entry.Name)
+	}
+}
+
+// Scrape HTTP Event Collector traffic and state per token. Traffic comes from a search over the
+// collector's introspection data, which knows nothing of idle tokens, so every token is listed as
+// well to report zeros for the enabled ones that received nothing and whether each is enabled
+func (s *instanceScraper) scrapeHECStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var tokens []hecEntry
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkHecDataReceivedBytes.Enabled && !metrics.SplunkHecRequestsCount.Enabled &&
+		!metrics.SplunkHecErrorsCount.Enabled && !metrics.SplunkHecTokenEnabled.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{
+		"splunk.hec.data.received.bytes": metrics.SplunkHecDataReceivedBytes.Enabled,
+		"splunk.hec.requests.count":      metrics.SplunkHecRequestsCount.Enabled,
+		"splunk.hec.errors.count":        metrics.SplunkHecErrorsCount.Enabled,
+	}, errs)
+
+	err := s.getAllPages(ctx, s.api[`SplunkHECTokens`], func(body []byte) (paging, int, error) {
+		var ht hecTokens
+		if err := json.Unmarshal(body, &ht); err != nil {
+			return paging{}, 0, err
+		}
+		tokens = append(tokens, ht.Entries...)
+		return ht.Paging, len(ht.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+	}
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	record := map[string]func(pcommon.Timestamp, int64, string){
+		"splunk.hec.data.received.bytes": s.mb.RecordSplunkHecDataReceivedBytesDataPoint,
+		"splunk.hec.requests.count":      s.mb.RecordSplunkHecRequestsCountDataPoint,
+		"splunk.hec.errors.count":        s.mb.RecordSplunkHecErrorsCountDataPoint,
+	}
+	for name, recordDataPoint := range record {
+		metricRows, ok := rows[name]
+		if !ok {
+			continue
+		}
+
+		// tokens without any traffic in the window are missing from the search results
+		seen := make(map[string]bool)
+		for _, row := range metricRows {
+			seen[row.attribute] = true
+			if v, err := strconv.ParseInt(row.value, 10, 64); err != nil {
+				errs.Add(err)
+			} else {
+				recordDataPoint(now, v, row.attribute)
+			}
+		}
+
+		for _, token := range tokens {
+			tokenName := strings.TrimPrefix(token.Name, "http://")
+			if seen[tokenName] || token.Content.Disabled.value != 0 {
+				continue
+			}
+			recordDataPoint(now, 0, tokenName)
+		}
+	}
+
+	if metrics.SplunkHecTokenEnabled.Enabled {
+		for _, token := range tokens {
+			var enabled int64
+			if token.Content.Disabled.value == 0 {
+				enabled = 1
+			}
+			s.mb.RecordSplunkHecTokenEnabledDataPoint(now, enabled, strings.TrimPrefix(token.Name, "http://"))
+		}
+	}
+}
+
+// Scrape how many searches are running and queued against how many the instance runs at once
+// before queueing them, and how long the oldest queued one has been waiting. Real-time searches
+// have a limit of their own
+func (s *instanceScraper) scrapeSearchConcurrency(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var running, queued, realtime int64
+	var oldestQueued time.Time
+	var limits searchConcurrency
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	jobs := metrics.SplunkSearchesRunningCount.Enabled || metrics.SplunkSearchesQueuedCount.Enabled ||
+		metrics.SplunkSearchesRealtimeRunningCount.Enabled || metrics.SplunkSchedulerOldestQueuedSeconds.Enabled
+	limit := metrics.SplunkSearchesLimit.Enabled || metrics.SplunkSearchesRealtimeLimit.Enabled
+	if !jobs && !limit {
+		return
+	}
+
+	if jobs {
+		err := s.getAllPages(ctx, s.api[`SplunkActiveSearchJobs`], func(body []byte) (paging, int, error) {
+			var asj activeSearchJobs
+			if err := json.Unmarshal(body, &asj); err != nil {
+				return paging{}, 0, err
+			}
+			for _, entry := range asj.Entries {
+				switch strings.ToUpper(entry.Content.DispatchState) {
+				case "QUEUED":
+					queued++
+					if published := entry.Published; published.ok && (oldestQueued.IsZero() || published.value.Before(oldestQueued)) {
+						oldestQueued = published.value
+					}
+				case "PARSING", "RUNNING", "FINALIZING":
+					running++
+					if entry.Content.IsRealTimeSearch {
+						realtime++
+					}
+				}
+			}
+			return asj.Paging, len(asj.Entries), nil
+		})
+		if err != nil {
+			errs.Add(err)
+			jobs = false
+		}
+	}
+
+	if limit {
+		if err := s.getAPI(ctx, s.api[`SplunkSearchConcurrency`], &limits); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	if jobs {
+		s.mb.RecordSplunkSearchesRunningCountDataPoint(now, running)
+		s.mb.RecordSplunkSearchesQueuedCountDataPoint(now, queued)
+		s.mb.RecordSplunkSearchesRealtimeRunningCountDataPoint(now, realtime)
+
+		// an empty queue keeps nothing waiting, and clocks out of step must not make the wait negative
+		var wait float64
+		if !oldestQueued.IsZero() {
+			wait = math.Max(now.AsTime().Sub(oldestQueued).Seconds(), 0)
+		}
+		s.mb.RecordSplunkSchedulerOldestQueuedSecondsDataPoint(now, wait)
+	}
+
+	for _, entry := range limits.Entries {
+		if entry.Content.MaxHistSearches.ok {
+			s.mb.RecordSplunkSearchesLimitDataPoint(now, int64(entry.Content.MaxHistSearches.value))
+		}
+		if entry.Content.MaxRtSearches.ok {
+			s.mb.RecordSplunkSearchesRealtimeLimitDataPoint(now, int64(entry.Content.MaxRtSearches.value))
+		}
+	}
+}
+
+// Scrape how many search artifacts the dispatch directory holds and how much disk they use. There
+// is one artifact per search job until the job expires, so listing the jobs tells us both on every
+// version, unlike the introspection of the directory itself. Jobs of other users are only listed
+// when the account is allowed to see them
+func (s *instanceScraper) scrapeDispatchDirUsage(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var count, used int64
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkDispatchArtifactCount.Enabled && !metrics.SplunkDispatchDiskUsedBytes.Enabled {
+		return
+	}
+
+	err := s.getAllPages(ctx, s.api[`SplunkDispatchArtifacts`], func(body []byte) (paging, int, error) {
+		var da dispatchArtifacts
+		if err := json.Unmarshal(body, &da); err != nil {
+			return paging{}, 0, err
+		}
+		for _, entry := range da.Entries {
+			count++
+			used += int64(entry.Content.DiskUsage.value)
+		}
+		return da.Paging, len(da.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	s.mb.RecordSplunkDispatchArtifactCountDataPoint(now, count)
+	s.mb.RecordSplunkDispatchDiskUsedBytesDataPoint(now, used)
+}
+
+// Scrape how many historical searches every user is running against the quota of their roles. A
+// user gets the highest quota of their roles, inherited ones included, and users none of whose
+// roles sets a quota are left out. Real-time searches count against a quota of their own
+func (s *instanceScraper) scrapeUserDispatchQuota(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkUserDispatchQuotaUsed.Enabled && !metrics.SplunkUserDispatchQuotaLimit.Enabled {
+		return
+	}
+
+	roleQuotas := make(map[string]int64)
+	err := s.getAllPages(ctx, s.api[`SplunkRoles`], func(body []byte) (paging, int, error) {
+		var r roles
+		if err := json.Unmarshal(body, &r); err != nil {
+			return paging{}, 0, err
+		}
+		for _, entry := range r.Entries {
+			roleQuotas[entry.Name] = int64(math.Max(entry.Content.SrchJobsQuota.value, entry.Content.ImportedSrchJobsQuota.value))
+		}
+		return r.Paging, len(r.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	quotas := make(map[string]int64)
+	err = s.getAllPages(ctx, s.api[`SplunkUsers`], func(body []byte) (paging, int, error) {
+		var u users
+		if err := json.Unmarshal(body, &u); err != nil {
+			return paging{}, 0, err
+		}
+		for _, entry := range u.Entries {
+			if !s.userAllowed(entry.Name) {
+				continue
+			}
+			var quota int64
+			for _, role := range entry.Content.Roles {
+				if roleQuotas[role] > quota {
+					quota = roleQuotas[role]
+				}
+			}
+			if quota > 0 {
+				quotas[entry.Name] = quota
+			}
+		}
+		return u.Paging, len(u.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	used := make(map[string]int64, len(quotas))
+	if metrics.SplunkUserDispatchQuotaUsed.Enabled {
+		err = s.getAllPages(ctx, s.api[`SplunkActiveSearchJobs`], func(body []byte) (paging, int, error) {
+			var asj activeSearchJobs
+			if err := json.Unmarshal(body, &asj); err != nil {
+				return paging{}, 0, err
+			}
+			for _, entry := range asj.Entries {
+				if entry.Content.IsRealTimeSearch {
+					continue
+				}
+				switch strings.ToUpper(entry.Content.DispatchState) {
+				case "PARSING", "RUNNING", "FINALIZING":
+					used[entry.Author]++
+				}
+			}
+			return asj.Paging, len(asj.Entries), nil
+		})
+		if err != nil {
+			errs.Add(err)
+			used = nil
+		}
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for user, quota := range quotas {
+		s.mb.RecordSplunkUserDispatchQuotaLimitDataPoint(now, quota, user)
+		if used != nil {
+			s.mb.RecordSplunkUserDispatchQuotaUsedDataPoint(now, used[user], user)
+		}
+	}
+}
+
+// Scrape the SmartStore cache manager of every index backed by remote storage. Indexers without
+// any such index don't serve the cache manager endpoint so nothing is recorded for them
+func (s *instanceScraper) scrapeSmartStoreCache(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var cm cacheManagerMetrics
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkSmartstoreCacheHitRatio.Enabled && !metrics.SplunkSmartstoreUploadPendingCount.Enabled &&
+		!metrics.SplunkSmartstoreEvictionCount.Enabled {
+		return
+	}
+
+	err := s.getAPI(ctx, s.api[`SplunkCacheManager`], &cm)
+	if errors.Is(err, errNotFound) || errors.Is(err, errForbidden) {
+		return
+	}
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range cm.Entries {
+		c := entry.Content
+		// nothing was looked up in the cache of the index since splunkd started
+		if lookups := c.CacheHits.value + c.CacheMisses.value; lookups > 0 {
+			s.mb.RecordSplunkSmartstoreCacheHitRatioDataPoint(now, c.CacheHits.value/lookups, entry.Name)
+		}
+		s.mb.RecordSplunkSmartstoreUploadPendingCountDataPoint(now, int64(c.PendingUploads.value), entry.Name)
+		s.mb.RecordSplunkSmartstoreEvictionCountDataPoint(now, int64(c.Evictions.value), entry.Name)
+	}
+}
+
+// Scrape how many lookups of the search results cache found the results of the search in it.
+// Nothing is recorded while the cache is turned off, on versions without it or before it was
+// first looked up
+func (s *instanceScraper) scrapeSearchResultsCache(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var src searchResultsCache
+
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSearchCacheHitRatio.Enabled {
+		return
+	}
+
+	err := s.getAPI(ctx, s.api[`SplunkSearchResultsCache`], &src)
+	if errors.Is(err, errNotFound) || errors.Is(err, errForbidden) {
+		return
+	}
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range src.Entries {
+		c := entry.Content
+		if c.Enabled.ok && c.Enabled.value == 0 {
+			continue
+		}
+		if lookups := c.Hits.value + c.Misses.value; lookups > 0 {
+			s.mb.RecordSplunkSearchCacheHitRatioDataPoint(now, c.Hits.value/lookups)
+		}
+	}
+}
+
+// Scrape how many connections every forwarder has open to the indexer and how much data it sent
+// from the indexer's metrics.log. Every forwarder is its own timeseries, which is why both
+// metrics are disabled by default
+func (s *instanceScraper) scrapeForwarderConnections(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkForwarderConnectionsCount.Enabled && !metrics.SplunkForwarderDataReceivedBytes.Enabled {
+		return
+	}
+
+	rows := s.searchMetricRows(ctx, now, map[string]bool{
+		"splunk.forwarder.connections.count":   metrics.SplunkForwarderConnectionsCount.Enabled,
+		"splunk.forwarder.data.received.bytes": metrics.SplunkForwarderDataReceivedBytes.Enabled,
+	}, errs)
+
+	// Record the results
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, row := range rows["splunk.forwarder.connections.count"] {
+		if v, err := strconv.ParseInt(row.value, 10, 64); err != nil {
+			errs.Add(err)
+		} else {
+			s.mb.RecordSplunkForwarderConnectionsCountDataPoint(now, v, row.attribute)
+		}
+	}
+
+	for _, row := range rows["splunk.forwarder.data.received.bytes"] {
+		if v, err := strconv.ParseInt(row.value, 10, 64); err != nil {
+			errs.Add(err)
+		} else {
+			s.mb.RecordSplunkForwarderDataReceivedBytesDataPoint(now, v, row.attribute)
+		}
+	}
+}
+
+// Scrape the CPU time every processor of the indexer pipelines spent from the indexer's
+// metrics.log, to find which processor indexing is bottlenecked on
+func (s *instanceScraper) scrapePipelineCPU(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkPipelineCPUSeconds.Enabled {
+		return
+	}
+
+	results, err := s.sharedSearch(ctx, now, `SplunkPipelineCPUSearch`)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, r := range results {
+		if nonFinite(r.value("cpu_seconds")) {
+			continue
+		}
+		v, err := strconv.ParseFloat(r.value("cpu_seconds"), 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkPipelineCPUSecondsDataPoint(now, v, r.value("pipeline"), r.value("processor"))
+	}
+}
+
+// Scrape how far along the acceleration of every accelerated data model is and how much disk
+// its summary takes. Data models that are not accelerated have no summary to report
+func (s *instanceScraper) scrapeDataModelAcceleration(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var entries []dmsEntry
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkDatamodelAccelerationPercent.Enabled && !metrics.SplunkDatamodelAccelerationSizeBytes.Enabled {
+		return
+	}
+
+	err := s.getAllPages(ctx, s.api[`SplunkDataModelSummaries`], func(body []byte) (paging, int, error) {
+		var dms dataModelSummaries
+		if err := json.Unmarshal(body, &dms); err != nil {
+			return paging{}, 0, err
+		}
+		entries = append(entries, dms.Entries...)
+		return dms.Paging, len(dms.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	for _, entry := range entries {
+		// other kinds of summaries, e.g. report acceleration, are listed as well
+		if !strings.HasPrefix(entry.Name, "tstats:DM_") {
+			continue
+		}
+		if entry.Content.Complete.ok {
+			s.mb.RecordSplunkDatamodelAccelerationPercentDataPoint(now, 100*entry.Content.Complete.value, entry.dataModel())
+		}
+		if entry.Content.Size.ok {
+			s.mb.RecordSplunkDatamodelAccelerationSizeBytesDataPoint(now, int64(entry.Content.Size.value), entry.dataModel())
+		}
+	}
+}
+
+// Fetch every page of a REST API endpoint listing entries. Splunk may cap the number of entries
+// returned at once regardless of the count asked for, so we keep requesting from the offset
+// following the last entry read until the total reported in the paging block is reached.
+// decode is handed the body of each page and returns its paging block and how many entries it held.
+// The endpoint may come without a query string of its own, e.g. from endpoint_overrides
+func (s *instanceScraper) getAllPages(ctx context.Context, ept string, decode func([]byte) (paging, int, error)) error {
+	offset := 0
+
+	page, err := url.Parse(ept)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %s: %w", ept, err)
+	}
+
+	for {
+		if offset > 0 {
+			query := page.Query()
+			query.Set("offset", strconv.Itoa(offset))
+			page.RawQuery = query.Encode()
+		}
+
+		req, err := s.splunkClient.createAPIRequest(ctx, page.String())
+		if err != nil {
+			return err
+		}
+
+		res, err := s.splunkClient.makeRequest(req)
+		if err != nil {
+			return err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, ept)
+		}
+
+		p, n, err := decode(body)
+		if err != nil {
+			return err
+		}
+
+		offset = p.Offset + n
+		if n == 0 || offset >= p.Total {
+			return nil
+		}
+	}
+}
+
+// Scrape the status of this instance within its search head cluster. Standalone instances and
+// indexers do not expose the shcluster endpoints so nothing is recorded for them
+func (s *instanceScraper) scrapeSHCStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var member shcMemberInfo
+	var captain shcCaptainInfo
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	if !metrics.SplunkShcMemberStatus.Enabled && !metrics.SplunkShcCaptainElectionCount.Enabled &&
+		!metrics.SplunkShcReplicationStatus.Enabled {
+		return
+	}
+
+	err := s.getAPI(ctx, s.api[`SplunkSHCMemberInfo`], &member)
+	if errors.Is(err, errNotFound) {
+		return
+	}
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	if err = s.getAPI(ctx, s.api[`SplunkSHCCaptainInfo`], &captain); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	// without server info only the member GUID is missing from the resource
+	info := s.serverInfo(ctx)
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	if info != nil {
+		s.shcMemberGUID = info.GUID
+	}
+
+	for _, entry := range member.Entries {
+		m := entry.Content
+
+		var up, replicating int64
+		if strings.EqualFold(m.Status, "up") {
+			up = 1
+		}
+		if m.IsRegistered && !m.NoArtifactReplications {
+			replicating = 1
+		}
+		s.mb.RecordSplunkShcMemberStatusDataPoint(now, up, m.Status)
+		s.mb.RecordSplunkShcReplicationStatusDataPoint(now, replicating)
+	}
+
+	for _, entry := range captain.Entries {
+		// a captain elected after the last one we saw means there was an election in between
+		elected := entry.Content.ElectedCaptain
+		if s.shcLastElection != 0 && elected > s.shcLastElection {
+			s.shcElections++
+		}
+		if elected > s.shcLastElection {
+			s.shcLastElection = elected
+		}
+		s.mb.RecordSplunkShcCaptainElectionCountDataPoint(now, s.shcElections)
+	}
+}
+
+// Scrape the artifact replication jobs the search head cluster captain has pending and has seen
+// fail for every member. Only the captain knows about them, so every other instance is skipped
+func (s *instanceScraper) scrapeSHCReplication(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	var captain shcCaptainInfo
+	var members []shcCaptainMemberEntry
+	failures := map[string]int64{}
+
+	metrics := s.conf.MetricsBuilderConfig.Metrics
+	pending := metrics.SplunkShcReplicationPendingCount.Enabled
+	failed := metrics.SplunkShcArtifactReplicationFailures.Enabled
+	if !pending && !failed {
+		return
+	}
+
+	err := s.getAPI(ctx, s.api[`SplunkSHCCaptainInfo`], &captain)
+	if errors.Is(err, errNotFound) {
+		return
+	}
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	// without server info we cannot tell whether this instance is the captain, so we wait for
+	// the next scrape rather than report jobs another member may report as well
+	info := s.serverInfo(ctx)
+	if info == nil {
+		return
+	}
+
+	isCaptain := false
+	for _, c := range captain.Entries {
+		if c.Content.ID != "" && c.Content.ID == info.GUID {
+			isCaptain = true
+		}
+	}
+	if !isCaptain {
+		return
+	}
+
+	// the failures of members without failed jobs are 0, so members are needed either way
+	err = s.getAllPages(ctx, s.api[`SplunkSHCCaptainMembers`], func(body []byte) (paging, int, error) {
+		var cm shcCaptainMembers
+		if err := json.Unmarshal(body, &cm); err != nil {
+			return paging{}, 0, err
+		}
+		members = append(members, cm.Entries...)
+		return cm.Paging, len(cm.Entries), nil
+	})
+	if err != nil {
+		errs.Add(err)
+		pending = false
+	}
+
+	if failed {
+		err = s.getAllPages(ctx, s.api[`SplunkSHCCaptainJobs`], func(body []byte) (paging, int, error) {
+			var cj shcCaptainJobs
+			if err := json.Unmarshal(body, &cj); err != nil {
+				return paging{}, 0, err
+			}
+			for _, entry := range cj.Entries {
+				job := entry.Content
+				if strings.Contains(strings.ToLower(job.JobType), "replication") && strings.EqualFold(job.JobState, "failed") {
+					failures[job.Target]++
+				}
+			}
+			return cj.Paging, len(cj.Entries), nil
+		})
+		if err != nil {
+			errs.Add(err)
+			failed = false
+		}
+	}
+
+	s.mbMux.Lock()
+	defer s.mbMux.Unlock()
+
+	if pending {
+		for _, member := range members {
+			if member.Content.PendingJobCount.ok {
+				s.mb.RecordSplunkShcReplicationPendingCountDataPoint(now, int64(member.Content.PendingJobCount.value), member.Name)
+			}
+		}
+	}
+
+	// members without failed jobs report 0 so that a recovered member is seen recovering
+	if failed {
+		for _, member := range members {
+			if _, ok := failures[member.Name]; !ok {
+				failures[member.Name] = 0
+			}
+		}
+		for guid, count := range failures {
+			s.mb.RecordSplunkShcArtifactReplicationFailuresDataPoint(now, count, guid)
+		}
+	}
+}
+
+// Request a REST API endpoint and decode its JSON response into v. Returns errNotFound when
+// the endpoint does not exist on the instance and errForbidden when we may not use it
+func (s *instanceScraper) getAPI(ctx context.Context, ept string, v any) error {
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return errNotFound
+	case http.StatusForbidden:
+		return errForbidden
+	default:
+		return fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, ept)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
 }
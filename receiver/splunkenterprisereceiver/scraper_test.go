@@ -5,17 +5,29 @@ package splunkenterprisereceiver // import "github.com/open-telemetry/openteleme
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
-	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -30,12 +42,393 @@ func mockIndexerThroughput(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/introspection/indexer","updated":"2023-07-31T21:41:07+00:00","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"indexer","id":"https://34.213.134.166:8089/services/server/introspection/indexer/indexer","updated":"1970-01-01T00:00:00+00:00","links":{"alternate":"/services/server/introspection/indexer/indexer","list":"/services/server/introspection/indexer/indexer","edit":"/services/server/introspection/indexer/indexer"},"author":"system","acl":{"app":"","can_list":true,"can_write":true,"modifiable":false,"owner":"system","perms":{"read":["admin","splunk-system-role"],"write":["admin","splunk-system-role"]},"removable":false,"sharing":"system"},"content":{"average_KBps":25.579690815904478,"eai:acl":null,"reason":"","status":"normal"}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
 }
 
+func mockIndexerQueues(w http.ResponseWriter, _ *http.Request) {
+	status := http.StatusOK
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/introspection/queues","updated":"2023-07-31T21:41:07+00:00","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"AEQ","content":{"current_size":0,"current_size_bytes":0,"largest_size":1,"max_size_bytes":512000,"smallest_size":0}},{"name":"aggQueue","content":{"current_size":12,"current_size_bytes":256000,"largest_size":40,"max_size_bytes":1024000,"smallest_size":0}},{"name":"indexQueue","content":{"current_size":5,"current_size_bytes":51200,"largest_size":31,"max_size_bytes":512000,"smallest_size":0}},{"name":"parsingQueue","content":{"current_size":0,"current_size_bytes":0,"largest_size":3,"max_size_bytes":6144000,"smallest_size":0}},{"name":"typingQueue","content":{"current_size":0,"current_size_bytes":0,"largest_size":0,"max_size_bytes":0,"smallest_size":0}}],"paging":{"total":5,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// standalone instance, no replicationStatus is reported
+func mockKVStoreStatus(w http.ResponseWriter, _ *http.Request) {
+	status := http.StatusOK
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/kvstore/status","updated":"2023-07-31T21:41:07+00:00","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"status","id":"https://somehost:8089/services/kvstore/status/status","content":{"current":{"backupRestoreStatus":"Ready","disabled":0,"guid":"A2E4A9E6-0B6F-4A42-8E29-0A3F0C1D4E5F","port":8191,"standalone":1,"status":"starting","storageEngine":"wiredTiger"},"eai:acl":null,"externalInfo":{}}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// the document of a collection is serialized into a string by some versions, nested by others
+func mockKVStoreCollections(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/introspection/kvstore/collectionstats","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"collectionStats","content":{"data":["{\"ns\":\"search.SavedSearchHistory\",\"size\":2048,\"count\":12,\"avgObjSize\":170,\"storageSize\":16384,\"nindexes\":1}",{"ns":"lookup_app.assets","size":"1048576","count":"5120","avgObjSize":204,"storageSize":524288,"nindexes":2}]}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// serves the indexes a page of two at a time, like a deployment capping the entries per response
+func mockIndexesExtended(w http.ResponseWriter, r *http.Request) {
+	pages := map[string]string{
+		"":  `{"links":{},"origin":"https://somehost:8089/services/data/indexes","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"_internal","content":{"currentDBSizeMB":245,"totalEventCount":1254789,"total_bucket_count":12,"total_raw_size":1024,"minTime":"2023-09-01T08:00:00+00:00","maxTime":"2023-09-28T11:42:17+00:00","maxHotBuckets":"auto","datatype":"event","frozenTimePeriodInSecs":2592000,"maxTotalDataSizeMB":500000,"bucket_dirs":{"home":{"hot_bucket_count":"3","warm_bucket_count":"7"},"cold":{"bucket_count":"2"},"thawed":{"bucket_count":"1","size":"64"}}}},{"name":"main","content":{"currentDBSizeMB":12,"totalEventCount":5120,"total_bucket_count":3,"total_raw_size":2.5,"minTime":"1693555200","maxTime":1695901337,"maxHotBuckets":"auto_high_volume","datatype":"event","frozenTimePeriodInSecs":"188697600","maxTotalDataSizeMB":"500000","bucket_dirs":{"home":{"hot_bucket_count":"1","warm_bucket_count":"2"},"cold":{"bucket_count":"0"}}}}],"paging":{"total":3,"perPage":2,"offset":0},"messages":[]}`,
+		"2": `{"links":{},"origin":"https://somehost:8089/services/data/indexes","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"summary","content":{"currentDBSizeMB":1,"totalEventCount":0,"total_bucket_count":0,"total_raw_size":0,"minTime":"","maxTime":"","maxHotBuckets":"5"}}],"paging":{"total":3,"perPage":2,"offset":2},"messages":[]}`,
+	}
+
+	page, ok := pages[r.URL.Query().Get("offset")]
+	if !ok {
+		http.NotFoundHandler().ServeHTTP(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(page))
+}
+
+func mockSHCMemberInfo(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/shcluster/member/info","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"member","content":{"active_historical_search_count":2,"active_realtime_search_count":0,"adhoc_searchhead":false,"is_registered":true,"last_heartbeat_attempt":1690839667,"maintenance_mode":false,"no_artifact_replications":false,"peer_scheme_host_port":"https://sh1:8089","restart_state":"NoRestart","status":"Up"}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+func mockSHCCaptainInfo(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/shcluster/captain/info","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"captain","content":{"dynamic_captain":true,"elected_captain":1690838000,"id":"0A6E3C1B-5C2D-4F5A-9C4B-2F8E7D6C5B4A","initialized_flag":true,"label":"sh2","maintenance_mode":false,"mgmt_uri":"https://sh2:8089","rolling_restart_flag":false,"service_ready_flag":true}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+func mockServerInfo(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/info","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"server-info","content":{"build":"82c987350fde","guid":"3F2504E0-4F89-11D3-9A0C-0305E82C3301","host":"sh1","os_name":"Linux","server_roles":["search_head","shc_member"],"serverName":"sh1","version":"9.0.1"}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// a deployment server capping responses at a single client
+func mockDeploymentClients(w http.ResponseWriter, r *http.Request) {
+	pages := map[string]string{
+		"":  `{"links":{},"origin":"https://somehost:8089/services/deployment/server/clients","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"0e1f3b6c2a","content":{"dns":"uf1","hostname":"uf1","ip":"10.0.0.11","lastPhoneHomeTime":1690839600,"serverClasses":{"all_forwarders":{"restartSplunkd":false,"stateOnClient":"enabled"},"linux_hosts":{"restartSplunkd":false,"stateOnClient":"enabled"}},"utsname":"linux-x86_64"}}],"paging":{"total":2,"perPage":1,"offset":0},"messages":[]}`,
+		"1": `{"links":{},"origin":"https://somehost:8089/services/deployment/server/clients","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"7c4d2e9a1b","content":{"dns":"uf2","hostname":"uf2","ip":"10.0.0.12","lastPhoneHomeTime":1690839610,"serverClasses":{"all_forwarders":{"restartSplunkd":false,"stateOnClient":"enabled"}},"utsname":"windows-x64"}}],"paging":{"total":2,"perPage":1,"offset":1},"messages":[]}`,
+	}
+
+	page, ok := pages[r.URL.Query().Get("offset")]
+	if !ok {
+		http.NotFoundHandler().ServeHTTP(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(page))
+}
+
+// numbers come as strings, as they do from most Splunk versions
+// the tmpfs partition reports no capacity
+func mockPartitionsSpace(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/status/partitions-space","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"partitions-space","content":{"capacity":"102400","free":"40960","fs_type":"ext4","mount_point":"/opt/splunk"}},{"name":"partitions-space","content":{"capacity":512000,"free":409600,"fs_type":"xfs","mount_point":"/data/cold"}},{"name":"partitions-space","content":{"capacity":0,"free":0,"fs_type":"tmpfs","mount_point":"/run"}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// analyst gets the quota of power, the highest of its roles, and svc_hec none at all
+func mockUsers(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/authentication/users","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"admin","content":{"roles":["admin"]}},{"name":"analyst","content":{"roles":["user","power"]}},{"name":"svc_hec","content":{"roles":["hec_writer"]}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// power inherits the quota of user and raises it
+func mockRoles(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/authorization/roles","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"admin","content":{"srchJobsQuota":50,"imported_srchJobsQuota":10}},{"name":"hec_writer","content":{"srchJobsQuota":0,"imported_srchJobsQuota":0}},{"name":"power","content":{"srchJobsQuota":"10","imported_srchJobsQuota":"3"}},{"name":"user","content":{"srchJobsQuota":"3","imported_srchJobsQuota":"0"}}],"paging":{"total":4,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// the misc index has not been looked up in the cache yet
+func mockCacheManager(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/admin/cacheman/_metrics","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"main","content":{"cache_hits":"1800","cache_misses":"200","evictions":"37","pending_uploads":"4"}},{"name":"web","content":{"cache_hits":45,"cache_misses":15,"evictions":0,"pending_uploads":0}},{"name":"misc","content":{"cache_hits":0,"cache_misses":0,"evictions":0,"pending_uploads":1}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// the alert of the itsi app is left out by the apps of the scraper test
+func mockFiredAlerts(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/alerts/fired_alerts/-","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"scheduler__admin__search__RMD5errors_at_1694012400_101","author":"admin","acl":{"app":"search","owner":"admin"},"content":{"savedsearch_name":"Errors in the last hour","severity":3,"trigger_time":1694012400}},{"name":"scheduler__admin__search__RMD5errors_at_1694016000_102","author":"admin","acl":{"app":"search","owner":"admin"},"content":{"savedsearch_name":"Errors in the last hour","severity":3,"trigger_time":"1694016000"}},{"name":"scheduler__nobody__itsi__RMD5kpi_at_1694000000_7","author":"nobody","acl":{"app":"itsi","owner":"nobody"},"content":{"savedsearch_name":"KPI breach","severity":5,"trigger_time":1694000000}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+func mockSearchResultsCache(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/introspection/search/results_cache","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"results_cache","content":{"enabled":true,"hits":"312","misses":"88"}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+func mockHostwideUsage(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/status/resource-usage/hostwide","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"hostwide","content":{"cpu_arch":"x86_64","cpu_count":"8","cpu_idle_pct":"72.50","cpu_system_pct":"7.25","cpu_user_pct":"20.25","mem":"15884","mem_used":"6144.5","normalized_load_avg_1min":"0.42","os_name":"Linux"}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// numbers come as both strings and numbers, one entry lacks content altogether
+func mockProcessUsage(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/status/resource-usage/splunk-processes","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"1201","content":{"args":"-p 8089 start","pid":"1201","process":"splunkd","pct_cpu":"12.50","mem_used":"1024"}},{"name":"2210","content":{"args":"search --id=scheduler_search","pid":"2210","process":"splunkd","pct_cpu":3.5,"mem_used":256}},{"name":"1302","content":{"pid":"1302","process":"mongod","pct_cpu":"0.75","mem_used":"null"}},{"name":"1400"}],"paging":{"total":4,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+func mockClusterConfig(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/cluster/config","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"config","content":{"mode":"manager","replication_factor":3,"search_factor":"2"}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// the replication factor is not met as main is missing copies, see mockClusterIndexes
+func mockClusterGeneration(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/cluster/master/generation","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"master","content":{"generation_id":"42","pending_last_reason":"","replication_factor_met":"0","search_factor_met":"1"}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// main is missing a copy of 4 of its buckets
+func mockClusterIndexes(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/cluster/master/indexes","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"_internal","content":{"index_size":"104857600","is_searchable":true,"num_buckets":"30","replicated_copies_tracker":[{"actual_copies_per_slot":"30","expected_total_per_slot":"30"},{"actual_copies_per_slot":"30","expected_total_per_slot":"30"}]}},{"name":"main","content":{"index_size":"2097152","is_searchable":"0","num_buckets":"12","replicated_copies_tracker":[{"actual_copies_per_slot":"12","expected_total_per_slot":"12"},{"actual_copies_per_slot":8,"expected_total_per_slot":12}]}}],"paging":{"total":2,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// idx2 is up but its buckets are not searchable yet, idx3 is down with fixup jobs piling up
+func mockClusterPeers(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/cluster/master/peers","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"8B9AE6D9-E79B-4A2B-9C0D-1E2F3A4B5C6D","content":{"bucket_count":412,"is_searchable":true,"label":"idx1","pending_job_count":0,"replication_count":0,"search_state_counter":{"PendingSearchable":0,"Searchable":412,"SearchablePendingMask":0,"Unsearchable":0},"site":"default","status":"Up"}},{"name":"1C2D3E4F-5A6B-4C7D-8E9F-0A1B2C3D4E5F","content":{"bucket_count":398,"is_searchable":false,"label":"idx2","pending_job_count":"14","replication_count":"6","search_state_counter":{"PendingSearchable":37,"Searchable":361,"SearchablePendingMask":0,"Unsearchable":0},"site":"default","status":"Up"}},{"name":"F0E1D2C3-B4A5-4968-8776-655443322110","content":{"bucket_count":405,"is_searchable":false,"label":"idx3","pending_job_count":"3","search_state_counter":{"PendingSearchable":0,"Searchable":0,"SearchablePendingMask":0,"Unsearchable":405},"site":"default","status":"Down"}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// the auto generated pool draws on the whole stack quota
+func mockLicensePools(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/licenser/pools","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"auto_generated_pool_enterprise","content":{"description":"auto_generated_pool_enterprise","quota":"MAX","slaves":["*"],"stack_id":"enterprise","used_bytes":4294967296}},{"name":"security","content":{"description":"security team","quota":10737418240,"slaves":["*"],"stack_id":"enterprise","used_bytes":"2147483648"}}],"paging":{"total":2,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+func mockLicenseSlaves(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/licenser/slaves","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"3F2504E0-4F89-11D3-9A0C-0305E82C3301","content":{"label":"idx1","pool_ids":["auto_generated_pool_enterprise"]}},{"name":"9B2E1C44-7A3D-4E1F-8C5B-6D4A3B2C1D0E","content":{"label":"idx2","pool_ids":["security"]}},{"name":"C1D2E3F4-A5B6-4C7D-8E9F-0A1B2C3D4E5F","content":{"label":"sh1","pool_ids":["auto_generated_pool_enterprise"]}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// one search of each state that is not done yet, the finalizing one a real-time search
+func mockActiveSearchJobs(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/search/jobs","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"scheduler__admin__search__RMD5a_at_1695901200_1","author":"admin","content":{"dispatchState":"RUNNING"}},{"name":"rt_1695901337.42","author":"analyst","content":{"dispatchState":"FINALIZING","isRealTimeSearch":true}},{"name":"1695901338.43","published":"2023-09-28T11:42:18.000+00:00","content":{"dispatchState":"QUEUED"}},{"name":"1695901339.44","content":{"dispatchState":"PAUSED"}}],"paging":{"total":4,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// every job still holding an artifact, finished or not
+// two warnings against the enterprise pool, one against the whole stack and an informational
+// message that is neither
+func mockLicenseMessages(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/licenser/messages","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"2e5f1b0c9a8d7e6f","content":{"category":"pool_over_quota","create_time":1695859200,"description":"License pool auto_generated_pool_enterprise is over quota","pool_id":"auto_generated_pool_enterprise","severity":"WARN","slave_id":"3F2504E0-4F89-11D3-9A0C-0305E82C3301","stack_id":"enterprise"}},{"name":"4a3b2c1d0e9f8a7b","content":{"category":"pool_over_quota","create_time":1695772800,"description":"License pool auto_generated_pool_enterprise is over quota","pool_id":"auto_generated_pool_enterprise","severity":"WARN","slave_id":"C1D2E3F4-A5B6-4C7D-8E9F-0A1B2C3D4E5F","stack_id":"enterprise"}},{"name":"6c5d4e3f2a1b0c9d","content":{"category":"license_window","create_time":1695859200,"description":"The enterprise stack has exceeded its quota","pool_id":"","severity":"WARN","slave_id":"","stack_id":"enterprise"}},{"name":"8e7f6a5b4c3d2e1f","content":{"category":"orphan_peer","create_time":1695859200,"description":"Peer idx3 is not assigned to a pool","pool_id":"","severity":"INFO","slave_id":"D4E5F6A7-B8C9-4D0E-9F1A-2B3C4D5E6F7A","stack_id":"enterprise"}}],"paging":{"total":4,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+func mockDispatchArtifacts(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/search/jobs","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"scheduler__admin__search__RMD5a_at_1695901200_1","content":{"diskUsage":1048576}},{"name":"1695901337.42","content":{"diskUsage":524288}},{"name":"1695900001.12","content":{"diskUsage":8192}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// two data model summaries over two pages, one of them still being built, and a report
+// acceleration summary that is not a data model at all
+var mockDataModelSummariesPages = map[string]string{
+	"":  `{"links":{},"origin":"https://somehost:8089/services/admin/summarization","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"tstats:DM_Splunk_SA_CIM_Authentication","acl":{"app":"Splunk_SA_CIM","owner":"nobody"},"content":{"summary.complete":"1","summary.size":"52428800","summary.is_inprogress":"0"}},{"name":"tstats:DM_search_Web_Traffic","acl":{"app":"search","owner":"nobody"},"content":{"summary.complete":"0.425","summary.size":"1048576","summary.is_inprogress":"1"}}],"paging":{"total":3,"perPage":2,"offset":0},"messages":[]}`,
+	"2": `{"links":{},"origin":"https://somehost:8089/services/admin/summarization","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"1A2B3C4D5E6F_search_admin_NS4d2cc7e4d1a8b4e2","acl":{"app":"search","owner":"admin"},"content":{"summary.complete":"1","summary.size":"4096"}}],"paging":{"total":3,"perPage":2,"offset":2},"messages":[]}`,
+}
+
+func mockDataModelSummaries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(mockDataModelSummariesPages[r.URL.Query().Get("offset")]))
+}
+
+func mockSearchConcurrency(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/status/limits/search-concurrency","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"search-concurrency","content":{"max_auto_summary_searches":5,"max_hist_scheduled_searches":5,"max_hist_searches":10,"max_rt_scheduled_searches":5,"max_rt_searches":10}}],"paging":{"total":1,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// the idle token has not shown up in the introspection data, the disabled one never will
+func mockHECTokens(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/data/inputs/http","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"http://otel","content":{"disabled":false,"index":"main","token":"********"}},{"name":"http://idle","content":{"disabled":false,"index":"main","token":"********"}},{"name":"http://retired","content":{"disabled":true,"index":"main","token":"********"}}],"paging":{"total":3,"perPage":30,"offset":0},"messages":[]}`))
+}
+
+// canned results of the searches dispatched by the scraper, keyed by their searchDict entry
+var mockSearchResults = map[string]string{
+	// the second row is mangled, which must not cost us the rows around it
+	`SplunkLicenseIndexUsageSearch`:    `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>indexname</field><field>By</field></fieldOrder></meta><result offset='0'><field k='indexname'><value><text>_internal</text></value></field><field k='By'><value><text>1048576</text></value></field></result><result offset='1'><field k='indexname'><value><text>broken</text></value></field><field k='By'><value><text>n/a</text></value></field></result><result offset='2'><field k='By'><value><text>2048</text></value></field><field k='indexname'><value><text>main</text></value></field></result></results>`,
+	`SplunkHECSearch`:                  `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>token_name</field><field>bytes</field><field>requests</field><field>errors</field></fieldOrder></meta><result offset='0'><field k='token_name'><value><text>otel</text></value></field><field k='bytes'><value><text>52428800</text></value></field><field k='requests'><value><text>1200</text></value></field><field k='errors'><value><text>3</text></value></field></result></results>`,
+	`SplunkSourcetypeThroughputSearch`: `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>sourcetype</field><field>Bps</field></fieldOrder></meta><result offset='0'><field k='sourcetype'><value><text>access_combined</text></value></field><field k='Bps'><value><text>20480.5</text></value></field></result><result offset='1'><field k='sourcetype'><value><text>splunkd</text></value></field><field k='Bps'><value><text>1024</text></value></field></result></results>`,
+	`SplunkBucketEventsSearch`:         `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>index_name</field><field>rolled</field><field>frozen</field></fieldOrder></meta><result offset='0'><field k='index_name'><value><text>_internal</text></value></field><field k='rolled'><value><text>4</text></value></field><field k='frozen'><value><text>1</text></value></field></result><result offset='1'><field k='index_name'><value><text>main</text></value></field><field k='rolled'><value><text>1</text></value></field><field k='frozen'><value><text>0</text></value></field></result></results>`,
+	`SplunkForwarderConnectionsSearch`: `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>forwarder_guid</field><field>connections</field><field>bytes</field></fieldOrder></meta><result offset='0'><field k='forwarder_guid'><value><text>0C6C2C2E-3D1B-4F2A-9E55-3C8E3B1A6F10</text></value></field><field k='connections'><value><text>2</text></value></field><field k='bytes'><value><text>734003</text></value></field></result></results>`,
+	`SplunkPipelineCPUSearch`:          `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>pipeline</field><field>processor</field><field>cpu_seconds</field></fieldOrder></meta><result offset='0'><field k='pipeline'><value><text>indexerpipe</text></value></field><field k='processor'><value><text>indexer</text></value></field><field k='cpu_seconds'><value><text>12.375</text></value></field></result><result offset='1'><field k='pipeline'><value><text>typing</text></value></field><field k='processor'><value><text>regexreplacement</text></value></field><field k='cpu_seconds'><value><text>4.25</text></value></field></result></results>`,
+	`SplunkMCLicenseUsageSearch`:       `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>indexname</field><field>By</field></fieldOrder></meta><result offset='0'><field k='indexname'><value><text>main</text></value></field><field k='By'><value><text>8192</text></value></field></result></results>`,
+	`SplunkMCThroughputSearch`:         `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>sourcetype</field><field>Bps</field></fieldOrder></meta><result offset='0'><field k='sourcetype'><value><text>syslog</text></value></field><field k='Bps'><value><text>2048.5</text></value></field></result></results>`,
+	// stash is not allowed by sourcetypes in TestScraper
+	`SplunkSourcetypeVolumeSearch`: `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>sourcetype</field><field>count</field></fieldOrder></meta><result offset='0'><field k='sourcetype'><value><text>access_combined</text></value></field><field k='count'><value><text>182734</text></value></field></result><result offset='1'><field k='sourcetype'><value><text>splunkd</text></value></field><field k='count'><value><text>90211</text></value></field></result><result offset='2'><field k='sourcetype'><value><text>stash</text></value></field><field k='count'><value><text>48</text></value></field></result></results>`,
+	// stash is not allowed by sourcetypes in TestScraper
+	`SplunkIngestionLatencySearch`:    `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>sourcetype</field><field>latency</field></fieldOrder></meta><result offset='0'><field k='sourcetype'><value><text>access_combined</text></value></field><field k='latency'><value><text>4.625</text></value></field></result><result offset='1'><field k='sourcetype'><value><text>splunkd</text></value></field><field k='latency'><value><text>1.5</text></value></field></result><result offset='2'><field k='sourcetype'><value><text>stash</text></value></field><field k='latency'><value><text>310</text></value></field></result></results>`,
+	`SplunkIndexWriteRateSearch`:      `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>index_name</field><field>rate</field></fieldOrder></meta><result offset='0'><field k='index_name'><value><text>_internal</text></value></field><field k='rate'><value><text>412.35</text></value></field></result><result offset='1'><field k='index_name'><value><text>main</text></value></field><field k='rate'><value><text>87.5</text></value></field></result></results>`,
+	`SplunkIndexSearchDurationSearch`: `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>index_name</field><field>duration</field></fieldOrder></meta><result offset='0'><field k='index_name'><value><text>main</text></value></field><field k='duration'><value><text>2.375</text></value></field></result><result offset='1'><field k='index_name'><value><text>_internal</text></value></field><field k='duration'><value><text>0.412</text></value></field></result></results>`,
+	`SplunkIndexSearchRateSearch`:     `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>index_name</field><field>rate</field></fieldOrder></meta><result offset='0'><field k='index_name'><value><text>main</text></value></field><field k='rate'><value><text>15203.117</text></value></field></result></results>`,
+	// only search and lookup_app are allowed by apps in TestScraper
+	`SplunkSearchesByAppSearch`: `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>app</field><field>searches</field></fieldOrder></meta><result offset='0'><field k='app'><value><text>search</text></value></field><field k='searches'><value><text>57</text></value></field></result><result offset='1'><field k='app'><value><text>lookup_app</text></value></field><field k='searches'><value><text>9</text></value></field></result><result offset='2'><field k='app'><value><text>splunk_monitoring_console</text></value></field><field k='searches'><value><text>112</text></value></field></result></results>`,
+	// only the first saved search is allowed by saved_searches in TestScraper, the second has no alert actions
+	`SplunkSchedulerSearch`:      `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>savedsearch_name</field><field>lag</field><field>run_time</field><field>fired</field><field>suppressed</field></fieldOrder></meta><result offset='0'><field k='savedsearch_name'><value><text>Errors in the last hour</text></value></field><field k='lag'><value><text>12.5</text></value></field><field k='run_time'><value><text>4.25</text></value></field><field k='fired'><value><text>2</text></value></field><field k='suppressed'><value><text>5</text></value></field></result><result offset='1'><field k='savedsearch_name'><value><text>Noisy report</text></value></field><field k='lag'><value><text>0</text></value></field><field k='run_time'><value><text>1</text></value></field></result><result offset='2'><field k='savedsearch_name'><value><text>Brute force attempts</text></value></field><field k='lag'><value><text>0.5</text></value></field><field k='run_time'><value><text>2</text></value></field><field k='fired'><value><text>1</text></value></field><field k='suppressed'><value><text>0</text></value></field></result></results>`,
+	`SplunkSchedulerSkipsSearch`: `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>savedsearch_name</field><field>reason</field><field>skipped</field></fieldOrder></meta><result offset='0'><field k='savedsearch_name'><value><text>Errors in the last hour</text></value></field><field k='reason'><value><text>The maximum number of concurrent historical scheduled searches on this instance has been reached</text></value></field><field k='skipped'><value><text>2</text></value></field></result><result offset='1'><field k='savedsearch_name'><value><text>Errors in the last hour</text></value></field><field k='reason'><value><text>The maximum number of concurrent running jobs for this historical scheduled search on this cluster has been reached</text></value></field><field k='skipped'><value><text>1</text></value></field></result><result offset='2'><field k='savedsearch_name'><value><text>Noisy report</text></value></field><field k='reason'><value><text>Search not executed: The maximum disk usage quota for this user has been reached</text></value></field><field k='skipped'><value><text>4</text></value></field></result><result offset='3'><field k='savedsearch_name'><value><text>Brute force attempts</text></value></field><field k='reason'><value><text></text></value></field><field k='skipped'><value><text>1</text></value></field></result></results>`,
+}
+
+// dispatches searches under a sid named after their searchDict entry and serves their canned results
+func mockSearchJobs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		_ = r.ParseForm()
+		for name, search := range searchDict {
+			if search == "search="+r.Form.Get("search") {
+				w.WriteHeader(http.StatusCreated)
+				writeSearchResponse(w, r, `<response><sid>`+name+`</sid></response>`)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	case http.MethodDelete:
+		w.WriteHeader(http.StatusOK)
+	default:
+		sid := strings.TrimPrefix(r.URL.Path, "/servicesNS/nobody/search/search/jobs/")
+		if !strings.HasSuffix(sid, "/results") {
+			// statistics of the finished job
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/search/jobs","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"` + sid + `","content":{"dispatchState":"DONE","eventCount":1532,"isDone":true,"resultCount":2,"runDuration":1.872,"scanCount":20480,"sid":"` + sid + `"}}],"paging":{"total":1,"perPage":0,"offset":0},"messages":[]}`))
+			return
+		}
+		results, ok := mockSearchResults[strings.TrimSuffix(sid, "/results")]
+		if !ok {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		writeSearchResponse(w, r, results)
+	}
+}
+
+// writes a search response given as XML the way Splunk answers it: as is unless the request
+// asked for output_mode=json, in which case it is rewritten into the JSON Splunk sends instead
+func writeSearchResponse(w http.ResponseWriter, r *http.Request, body string) {
+	if err := r.ParseForm(); err != nil || r.Form.Get("output_mode") != searchOutputModeJSON {
+		_, _ = w.Write([]byte(body))
+		return
+	}
+
+	var sr searchResponse
+	if err := xml.Unmarshal([]byte(body), &sr); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if sr.Jobid != nil {
+		out, _ := json.Marshal(map[string]string{"sid": *sr.Jobid})
+		_, _ = w.Write(out)
+		return
+	}
+
+	// rows are written by hand to keep their fields in order, which a map would lose
+	var b strings.Builder
+	b.WriteString(`{"preview":false,"init_offset":0,"messages":[],"results":[`)
+	for i, row := range sr.Results {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("{")
+		for j, f := range row.Fields {
+			if j > 0 {
+				b.WriteString(",")
+			}
+			k, _ := json.Marshal(f.FieldName)
+			v, _ := json.Marshal(f.Value)
+			b.Write(k)
+			b.WriteString(":")
+			b.Write(v)
+		}
+		b.WriteString("}")
+	}
+	b.WriteString("]}")
+	_, _ = w.Write([]byte(b.String()))
+}
+
 // mock server create
 func createMockServer() *httptest.Server {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		switch strings.TrimSpace(r.URL.Path) {
+		path := strings.TrimSpace(r.URL.Path)
+		if strings.HasPrefix(path, "/servicesNS/nobody/search/search/jobs/") {
+			mockSearchJobs(w, r)
+			return
+		}
+
+		switch path {
 		case "/services/server/introspection/indexer":
 			mockIndexerThroughput(w, r)
+		case "/services/server/introspection/queues":
+			mockIndexerQueues(w, r)
+		case "/services/kvstore/status":
+			mockKVStoreStatus(w, r)
+		case "/services/server/introspection/kvstore/collectionstats":
+			mockKVStoreCollections(w, r)
+		case "/services/data/indexes":
+			mockIndexesExtended(w, r)
+		case "/services/shcluster/member/info":
+			mockSHCMemberInfo(w, r)
+		case "/services/shcluster/captain/info":
+			mockSHCCaptainInfo(w, r)
+		case "/services/server/info":
+			mockServerInfo(w, r)
+		case "/services/deployment/server/clients":
+			mockDeploymentClients(w, r)
+		case "/services/data/inputs/http":
+			mockHECTokens(w, r)
+		case "/services/licenser/pools":
+			mockLicensePools(w, r)
+		case "/services/licenser/slaves":
+			mockLicenseSlaves(w, r)
+		case "/services/licenser/messages":
+			mockLicenseMessages(w, r)
+		case "/services/cluster/master/generation":
+			mockClusterGeneration(w, r)
+		case "/services/cluster/config":
+			mockClusterConfig(w, r)
+		case "/services/cluster/master/indexes":
+			mockClusterIndexes(w, r)
+		case "/services/cluster/master/peers":
+			mockClusterPeers(w, r)
+		case "/services/server/status/resource-usage/hostwide":
+			mockHostwideUsage(w, r)
+		case "/services/server/status/partitions-space":
+			mockPartitionsSpace(w, r)
+		case "/services/server/status/resource-usage/splunk-processes":
+			mockProcessUsage(w, r)
+		case "/services/search/jobs":
+			if r.URL.Query().Get("f") == "diskUsage" {
+				mockDispatchArtifacts(w, r)
+				return
+			}
+			mockActiveSearchJobs(w, r)
+		case "/services/server/status/limits/search-concurrency":
+			mockSearchConcurrency(w, r)
+		case "/services/authentication/users":
+			mockUsers(w, r)
+		case "/services/authorization/roles":
+			mockRoles(w, r)
+		case "/services/admin/cacheman/_metrics":
+			mockCacheManager(w, r)
+		case "/services/server/introspection/search/results_cache":
+			mockSearchResultsCache(w, r)
+		case "/services/alerts/fired_alerts/-":
+			mockFiredAlerts(w, r)
+		case "/services/admin/summarization":
+			mockDataModelSummaries(w, r)
 		default:
 			http.NotFoundHandler().ServeHTTP(w, r)
 		}
@@ -50,15 +443,115 @@ func TestScraper(t *testing.T) {
 
 	// in the future add more metrics
 	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkUp.Enabled = true
 	metricsettings.Metrics.SplunkIndexerThroughput.Enabled = true
+	metricsettings.Metrics.SplunkIndexerThroughputBySourcetype.Enabled = true
+	metricsettings.Metrics.SplunkIndexerQueueRatio.Enabled = true
+	metricsettings.Metrics.SplunkIndexerQueueLatencySeconds.Enabled = true
+	metricsettings.Metrics.SplunkSearchScanCount.Enabled = true
+	metricsettings.Metrics.SplunkSearchEventCount.Enabled = true
+	metricsettings.Metrics.SplunkSearchRunDurationSeconds.Enabled = true
+	metricsettings.Metrics.SplunkReceiverSearchWaitSeconds.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerSkippedCount.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerLagSeconds.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerExecutionDuration.Enabled = true
+	metricsettings.Metrics.SplunkSavedsearchAlertFiredCount.Enabled = true
+	metricsettings.Metrics.SplunkSavedsearchAlertSuppressedCount.Enabled = true
+	metricsettings.Metrics.SplunkAlertsTriggeredCount.Enabled = true
+	metricsettings.Metrics.SplunkAlertsTriggeredOldestSeconds.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreStatus.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreReplicationStatus.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreBackupRestoreStatus.Enabled = true
+	metricsettings.Metrics.SplunkIndexBucketCount.Enabled = true
+	metricsettings.Metrics.SplunkIndexRawSizeBytes.Enabled = true
+	metricsettings.Metrics.SplunkIndexEventCount.Enabled = true
+	metricsettings.Metrics.SplunkIndexEarliestEventSeconds.Enabled = true
+	metricsettings.Metrics.SplunkIndexLatestEventSeconds.Enabled = true
+	metricsettings.Metrics.SplunkShcMemberStatus.Enabled = true
+	metricsettings.Metrics.SplunkShcCaptainElectionCount.Enabled = true
+	metricsettings.Metrics.SplunkShcReplicationStatus.Enabled = true
+	metricsettings.Metrics.SplunkDeploymentClientsCount.Enabled = true
+	metricsettings.Metrics.SplunkDeploymentServerclassClients.Enabled = true
+	metricsettings.Metrics.SplunkServerCPUUsagePercent.Enabled = true
+	metricsettings.Metrics.SplunkServerMemoryUsageBytes.Enabled = true
+	metricsettings.Metrics.SplunkProcessCPUPercent.Enabled = true
+	metricsettings.Metrics.SplunkProcessMemoryBytes.Enabled = true
+	metricsettings.Metrics.SplunkClusterIndexSearchable.Enabled = true
+	metricsettings.Metrics.SplunkClusterIndexReplicatedCopies.Enabled = true
+	metricsettings.Metrics.SplunkClusterFixupPendingCount.Enabled = true
+	metricsettings.Metrics.SplunkLicensePoolUsedBytes.Enabled = true
+	metricsettings.Metrics.SplunkLicensePoolQuotaBytes.Enabled = true
+	metricsettings.Metrics.SplunkLicenseSlaveCount.Enabled = true
+	metricsettings.Metrics.SplunkHecDataReceivedBytes.Enabled = true
+	metricsettings.Metrics.SplunkHecRequestsCount.Enabled = true
+	metricsettings.Metrics.SplunkHecErrorsCount.Enabled = true
+	metricsettings.Metrics.SplunkHecTokenEnabled.Enabled = true
+	metricsettings.Metrics.SplunkSearchesRunningCount.Enabled = true
+	metricsettings.Metrics.SplunkSearchesQueuedCount.Enabled = true
+	metricsettings.Metrics.SplunkSearchesLimit.Enabled = true
+	metricsettings.Metrics.SplunkSearchesRealtimeRunningCount.Enabled = true
+	metricsettings.Metrics.SplunkSearchesRealtimeLimit.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerOldestQueuedSeconds.Enabled = true
+	metricsettings.Metrics.SplunkUserDispatchQuotaUsed.Enabled = true
+	metricsettings.Metrics.SplunkUserDispatchQuotaLimit.Enabled = true
+	metricsettings.Metrics.SplunkSmartstoreCacheHitRatio.Enabled = true
+	metricsettings.Metrics.SplunkSmartstoreUploadPendingCount.Enabled = true
+	metricsettings.Metrics.SplunkSmartstoreEvictionCount.Enabled = true
+	metricsettings.Metrics.SplunkSearchCacheHitRatio.Enabled = true
+	metricsettings.Metrics.SplunkDatamodelAccelerationPercent.Enabled = true
+	metricsettings.Metrics.SplunkDatamodelAccelerationSizeBytes.Enabled = true
+	metricsettings.Metrics.SplunkForwarderConnectionsCount.Enabled = true
+	metricsettings.Metrics.SplunkForwarderDataReceivedBytes.Enabled = true
+	metricsettings.Metrics.SplunkDispatchArtifactCount.Enabled = true
+	metricsettings.Metrics.SplunkDispatchDiskUsedBytes.Enabled = true
+	metricsettings.Metrics.SplunkLicenseWarningCount.Enabled = true
+	metricsettings.Metrics.SplunkLicenseViolation.Enabled = true
+	metricsettings.Metrics.SplunkSearchTimeoutCount.Enabled = true
+	metricsettings.Metrics.SplunkPipelineCPUSeconds.Enabled = true
+	metricsettings.Metrics.SplunkIndexHotBucketsCount.Enabled = true
+	metricsettings.Metrics.SplunkIndexHotBucketsMax.Enabled = true
+	metricsettings.Metrics.SplunkIndexesCount.Enabled = true
+	metricsettings.Metrics.SplunkIndexFrozenTimeSeconds.Enabled = true
+	metricsettings.Metrics.SplunkIndexMaxSizeBytes.Enabled = true
+	metricsettings.Metrics.SplunkIndexThawedSizeBytes.Enabled = true
+	metricsettings.Metrics.SplunkClusterPeerFixupTasks.Enabled = true
+	metricsettings.Metrics.SplunkClusterPeerReplicationQueueLength.Enabled = true
+	metricsettings.Metrics.SplunkClusterPeerStatus.Enabled = true
+	metricsettings.Metrics.SplunkClusterReplicationFactor.Enabled = true
+	metricsettings.Metrics.SplunkClusterSearchFactor.Enabled = true
+	metricsettings.Metrics.SplunkClusterReplicationFactorMet.Enabled = true
+	metricsettings.Metrics.SplunkClusterSearchFactorMet.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreCollectionSizeBytes.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreCollectionCount.Enabled = true
+	// the mocked instance is not the captain, which skips these
+	metricsettings.Metrics.SplunkShcReplicationPendingCount.Enabled = true
+	metricsettings.Metrics.SplunkShcArtifactReplicationFailures.Enabled = true
+	metricsettings.Metrics.SplunkIndexBucketsRolledCount.Enabled = true
+	metricsettings.Metrics.SplunkIndexBucketsFrozenCount.Enabled = true
+	metricsettings.Metrics.SplunkSourcetypeEventCount.Enabled = true
+	metricsettings.Metrics.SplunkIndexerIngestionLatencySeconds.Enabled = true
+	metricsettings.Metrics.SplunkIndexEventsWrittenRate.Enabled = true
+	metricsettings.Metrics.SplunkIndexEventsSearchedRate.Enabled = true
+	metricsettings.Metrics.SplunkIndexSearchDurationSeconds.Enabled = true
+	metricsettings.Metrics.SplunkSearchCount.Enabled = true
+	metricsettings.Metrics.SplunkServerPartitionUsedBytes.Enabled = true
+	metricsettings.Metrics.SplunkServerPartitionFreeBytes.Enabled = true
+	metricsettings.Metrics.SplunkServerPartitionCapacityBytes.Enabled = true
+	metricsettings.ResourceAttributes = metadata.DefaultResourceAttributesConfig()
 
 	cfg := &Config{
-		Username:          "admin",
-		Password:          "securityFirst",
-		MaxSearchWaitTime: 11 * time.Second,
-		HTTPClientSettings: confighttp.HTTPClientSettings{
-			Endpoint: ts.URL,
-		},
+		Username:              "admin",
+		Password:              "securityFirst",
+		MaxSearchWaitTime:     11 * time.Second,
+		SearchPollInterval:    10 * time.Millisecond,
+		MaxSearchPollInterval: time.Second,
+		MaxConcurrentSearches: 2,
+		LicenseIndexField:     "indexname",
+		LicenseBytesField:     "By",
+		SavedSearches:         []string{"Errors in the last hour"},
+		Sourcetypes:           []string{"access_combined", "splunkd"},
+		Apps:                  []string{"search", "lookup_app"},
+		Instances:             []InstanceConfig{{Name: "indexer1", Endpoint: ts.URL}},
 		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
 			CollectionInterval: 10 * time.Second,
 			InitialDelay:       1 * time.Second,
@@ -69,8 +562,7 @@ func TestScraper(t *testing.T) {
 	require.NoError(t, cfg.Validate())
 
 	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
-	client := newSplunkEntClient(cfg)
-	scraper.splunkClient = &client
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
 
 	actualMetrics, err := scraper.scrape(context.Background())
 	require.NoError(t, err)
@@ -81,5 +573,1786 @@ func TestScraper(t *testing.T) {
 	expectedMetrics, err := golden.ReadMetrics(expectedFile)
 	require.NoError(t, err)
 
-	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+	// searches finish in no particular order, so neither do the datapoints describing them
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreMetricValues("splunk.receiver.search.wait.seconds", "splunk.scheduler.oldest.queued.seconds",
+			"splunk.alerts.triggered.oldest.seconds")))
+
+	// however long the searches took, every datapoint is stamped with the time of the scrape
+	timestamps := make(map[pcommon.Timestamp]bool)
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		sms := actualMetrics.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				var dps pmetric.NumberDataPointSlice
+				if ms.At(k).Type() == pmetric.MetricTypeSum {
+					dps = ms.At(k).Sum().DataPoints()
+				} else {
+					dps = ms.At(k).Gauge().DataPoints()
+				}
+				for l := 0; l < dps.Len(); l++ {
+					timestamps[dps.At(l).Timestamp()] = true
+				}
+			}
+		}
+	}
+	require.Len(t, timestamps, 1)
+}
+
+// the shcluster endpoints do not exist on standalone instances, which is not an error
+func TestScrapeSHCStatusStandalone(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics.SplunkShcMemberStatus.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSHCStatus(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, 0, scraper.instances[0].mb.Emit().DataPointCount())
+}
+
+// a member whose server info cannot be had still reports its status, just without its GUID
+func TestScrapeSHCStatusWithoutServerInfo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/shcluster/member/info":
+			mockSHCMemberInfo(w, r)
+		case "/services/shcluster/captain/info":
+			mockSHCCaptainInfo(w, r)
+		default:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MaxRequestRetries = 0
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkShcMemberStatus.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkShcCaptainElectionCount.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSHCStatus(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Empty(t, scraper.instances[0].shcMemberGUID)
+	require.Equal(t, 2, scraper.instances[0].mb.Emit().DataPointCount())
+}
+
+func TestScrapeUp(t *testing.T) {
+	ts := createMockServer()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MaxRequestRetries = 0
+	// nothing but the heartbeat, which has to probe the deployment by itself
+	cfg.MetricsBuilderConfig = metadata.MetricsBuilderConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkUp.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, metrics.DataPointCount())
+	up := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "splunk.up", up.Name())
+	require.Equal(t, int64(1), up.Gauge().DataPoints().At(0).IntValue())
+
+	ts.Close()
+
+	metrics, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	up = metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, int64(0), up.Gauge().DataPoints().At(0).IntValue())
+}
+
+// an unreachable instance reports itself down without costing us the metrics of the others
+func TestScrapeMultipleInstances(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MaxRequestRetries = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.Instances = []InstanceConfig{
+		{Name: "indexer1", Endpoint: ts.URL},
+		{Name: "indexer2", Endpoint: down.URL},
+	}
+	// the mocked license usage search carries a row that fails to parse
+	cfg.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled = false
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, "instance indexer2")
+	require.NotContains(t, err.Error(), "instance indexer1")
+
+	rms := metrics.ResourceMetrics()
+	require.Equal(t, 2, rms.Len())
+	for i, name := range []string{"indexer1", "indexer2"} {
+		instance, ok := rms.At(i).Resource().Attributes().Get("splunk.instance")
+		require.True(t, ok)
+		require.Equal(t, name, instance.Str())
+	}
+	require.Greater(t, rms.At(0).ScopeMetrics().At(0).Metrics().Len(), 1)
+
+	// nothing but the heartbeat is left of the unreachable instance
+	downMetrics := rms.At(1).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, downMetrics.Len())
+	require.Equal(t, "splunk.up", downMetrics.At(0).Name())
+	require.Equal(t, int64(0), downMetrics.At(0).Gauge().DataPoints().At(0).IntValue())
+}
+
+// rejected credentials fail start, an instance we cannot reach does not
+func TestStartVerifyConnection(t *testing.T) {
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer rejecting.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	tests := []struct {
+		desc          string
+		endpoint      string
+		sessionKeyTTL time.Duration
+		verify        bool
+		expected      error
+	}{
+		{desc: "Rejected basic auth", endpoint: rejecting.URL, verify: true, expected: errRejectedCredentials},
+		{desc: "Rejected login", endpoint: rejecting.URL, sessionKeyTTL: time.Minute, verify: true, expected: errFailedLogin},
+		{desc: "Verification disabled", endpoint: rejecting.URL},
+		{desc: "Unreachable", endpoint: down.URL, verify: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = test.endpoint
+			cfg.Username = "admin"
+			cfg.Password = "securityFirst"
+			cfg.SessionKeyTTL = test.sessionKeyTTL
+			cfg.MaxRequestRetries = 0
+			cfg.VerifyConnectionOnStart = test.verify
+
+			scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			if test.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, test.expected)
+			require.ErrorIs(t, err, errRejectedCredentials)
+		})
+	}
+}
+
+// server info is requested on the first scrape only and describes every scrape after it
+func TestServerInfoCached(t *testing.T) {
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		mockServerInfo(w, r)
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.VerifyConnectionOnStart = false
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkUp.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	for i := 0; i < 2; i++ {
+		metrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		version, ok := metrics.ResourceMetrics().At(0).Resource().Attributes().Get("splunk.version")
+		require.True(t, ok)
+		require.Equal(t, "9.0.1", version.Str())
+	}
+
+	// one for the heartbeat of each scrape and one for the server info
+	require.Equal(t, int32(3), requests.Load())
+}
+
+// a search head under load turns the request down once and the datapoint is still recorded
+func TestScrapeRateLimited(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	var limited atomic.Bool
+	rateLimiting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limited.CompareAndSwap(false, true) {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer rateLimiting.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = rateLimiting.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.VerifyConnectionOnStart = false
+	// a 429 is retried even when other failures are not, after a wait bounded by the max search wait time
+	cfg.MaxRequestRetries = 0
+	cfg.MaxSearchWaitTime = 50 * time.Millisecond
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexerQueueRatio.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeIndexerQueues(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.True(t, limited.Load())
+	require.Greater(t, scraper.instances[0].mb.Emit().DataPointCount(), 0)
+}
+
+// an idle indexer drains nothing, which leaves the queue latency undefined rather than infinite
+func TestScrapeQueueLatencyIdle(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	idle := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/server/introspection/indexer" {
+			_, _ = w.Write([]byte(`{"entry":[{"name":"indexer","content":{"average_KBps":0,"status":"normal"}}]}`))
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer idle.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = idle.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexerQueueLatencySeconds.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeIndexerQueues(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, 0, scraper.instances[0].mb.Emit().DataPointCount())
+}
+
+// idle indexers have been seen reporting NaN and Inf, neither of which may make it into a datapoint
+func TestScrapeNonFiniteValues(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/server/introspection/indexer" {
+			_, _ = w.Write([]byte(`{"entry":[{"name":"indexer","content":{"average_KBps":"NaN","status":"normal"}},{"name":"indexer","content":{"average_KBps":"+Inf","status":"throttled"}}]}`))
+			return
+		}
+		http.NotFoundHandler().ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexerThroughput.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeIndexThroughput(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, 0, scraper.instances[0].mb.Emit().DataPointCount())
+
+	// rows of search results holding them are dropped, the others are kept
+	result := func(index, value string) searchResult {
+		return searchResult{Fields: []*field{{FieldName: "indexname", Value: index}, {FieldName: "By", Value: value}}}
+	}
+	rows := metricRowsOf([]searchResult{result("main", "NaN"), result("summary", "-Inf"), result("_internal", "1024.5")}, "By", "indexname", "")
+	require.Equal(t, []metricRow{{value: "1024.5", attribute: "_internal"}}, rows)
+}
+
+// skipping certificate verification is warned about once, for the instances it applies to only
+func TestStartInsecureSkipVerifyWarning(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkUp.Enabled = true
+	cfg.Instances = []InstanceConfig{
+		{Name: "indexer1", Endpoint: ts.URL, InsecureSkipVerify: true},
+		{Name: "indexer2", Endpoint: ts.URL},
+	}
+
+	core, logs := observer.New(zap.WarnLevel)
+	settings := receivertest.NewNopCreateSettings()
+	settings.Logger = zap.New(core)
+
+	scraper := newSplunkMetricsScraper(settings, cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	for i := 0; i < 2; i++ {
+		_, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+	}
+
+	warnings := logs.FilterMessage("TLS certificate verification is disabled for the Splunk instance").All()
+	require.Len(t, warnings, 1)
+	require.Equal(t, "indexer1", warnings[0].ContextMap()["instance"])
+}
+
+// every scrape of an instance ends with a summary at info level
+func TestScrapeSummaryLog(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/server/introspection/queues" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer failing.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = failing.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MaxRequestRetries = 0
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkUp.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexerThroughput.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexerQueueRatio.Enabled = true
+
+	core, logs := observer.New(zap.InfoLevel)
+	settings := receivertest.NewNopCreateSettings()
+	settings.Logger = zap.New(core)
+
+	scraper := newSplunkMetricsScraper(settings, cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	_, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+
+	// only the scrape functions of the queues and the throughput have metrics enabled and run
+	summaries := logs.FilterMessage("Splunk scrape complete").All()
+	require.Len(t, summaries, 1)
+	fields := summaries[0].ContextMap()
+	require.Equal(t, int64(1), fields["failed"])
+	require.Equal(t, int64(1), fields["ok"])
+	require.Equal(t, int64(2), fields["datapoints"])
+
+	// with the queue metrics disabled their scrape function counts as neither
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexerQueueRatio.Enabled = false
+	scraper = newSplunkMetricsScraper(settings, cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	_, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	summaries = logs.FilterMessage("Splunk scrape complete").All()
+	require.Len(t, summaries, 2)
+	fields = summaries[1].ContextMap()
+	require.Equal(t, int64(0), fields["failed"])
+	require.Equal(t, int64(1), fields["ok"])
+}
+
+func TestScrapeEveryMetricDisabled(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.Endpoint = ts.URL
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+
+	core, logs := observer.New(zap.WarnLevel)
+	settings := receivertest.NewNopCreateSettings()
+	settings.Logger = zap.New(core)
+
+	scraper := newSplunkMetricsScraper(settings, cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	require.Equal(t, 1, logs.FilterMessage("Every metric of the Splunk Enterprise receiver is disabled, nothing is going to be scraped").Len())
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, md.ResourceMetrics().Len())
+
+	// a single enabled metric is all that is emitted
+	cfg.MetricsBuilderConfig.Metrics.SplunkUp.Enabled = true
+	scraper = newSplunkMetricsScraper(settings, cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.MetricCount())
+	require.Equal(t, "splunk.up", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestStartEndpointScheme(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.VerifyConnectionOnStart = false
+	cfg.Instances = []InstanceConfig{
+		{Endpoint: "localhost:8089"},
+		{Name: "plain", Endpoint: "http://splunk.internal:8089"},
+		{Name: "proxied", Endpoint: "http://splunk.internal:8000"},
+	}
+	require.NoError(t, cfg.Validate())
+
+	core, logs := observer.New(zap.WarnLevel)
+	settings := receivertest.NewNopCreateSettings()
+	settings.Logger = zap.New(core)
+
+	scraper := newSplunkMetricsScraper(settings, cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// no scheme means https, and the instance is named after its host and port all the same
+	require.Equal(t, "localhost:8089", scraper.instances[0].instance.Name)
+	require.Equal(t, "https://localhost:8089/services/server/info", scraper.instances[0].splunkClient.endpointURL("/services/server/info"))
+
+	warnings := logs.FilterMessage("The Splunk management port is reached over http, it serves https unless SSL was turned off on it").All()
+	require.Len(t, warnings, 1)
+	require.Equal(t, "plain", warnings[0].ContextMap()["instance"])
+}
+
+func TestUnmarshallSearchReq(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SearchOutputMode = searchOutputModeXML
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+
+	newResponse := func(status int, body string) *http.Response {
+		return &http.Response{
+			StatusCode:    status,
+			ContentLength: int64(len(body)),
+			Body:          io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	// still running
+	sr := searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	require.NoError(t, scraper.unmarshallSearchReq(newResponse(http.StatusNoContent, ""), &sr))
+	require.Equal(t, http.StatusNoContent, sr.Return)
+
+	// truncated on the way
+	sr = searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	err := scraper.unmarshallSearchReq(newResponse(http.StatusOK, `<results><result><field k="indexname"><value><text>ma`), &sr)
+	require.ErrorIs(t, err, errCorruptSearchResponse)
+	require.Contains(t, err.Error(), `SplunkLicenseIndexUsageSearch`)
+	require.Equal(t, http.StatusOK, sr.Return)
+
+	// not even utf-8
+	sr = searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	err = scraper.unmarshallSearchReq(newResponse(http.StatusOK, "<results>\xff\xfe</results>"), &sr)
+	require.ErrorIs(t, err, errCorruptSearchResponse)
+}
+
+func TestUnmarshallSearchReqJSON(t *testing.T) {
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config))
+
+	newResponse := func(status int, body string) *http.Response {
+		return &http.Response{
+			StatusCode:    status,
+			ContentLength: int64(len(body)),
+			Body:          io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	// dispatched
+	sr := searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	require.NoError(t, scraper.unmarshallSearchReq(newResponse(http.StatusCreated, `{"sid":"1695901337.42"}`), &sr))
+	require.NotNil(t, sr.Jobid)
+	require.Equal(t, "1695901337.42", *sr.Jobid)
+
+	// a page of results, appended to the rows read before, with a multivalue field of which
+	// the first value is kept and fields in the order they were written
+	sr.Results = []searchResult{{Fields: []*field{{FieldName: "indexname", Value: "_internal"}}}}
+	sr.offset = 1
+	body := `{"preview":false,"init_offset":1,"messages":[],"fields":[{"name":"indexname"},{"name":"By"}],"results":[{"indexname":["main","web"],"By":"1024"},{"By":"2048","indexname":"summary","missing":null}]}`
+	require.NoError(t, scraper.unmarshallSearchReq(newResponse(http.StatusOK, body), &sr))
+	require.Equal(t, "1695901337.42", *sr.Jobid)
+	require.Len(t, sr.Results, 3)
+	require.Equal(t, "main", sr.Results[1].value("indexname"))
+	require.Equal(t, "1024", sr.Results[1].value("By"))
+	require.Equal(t, "By", sr.Results[2].Fields[0].FieldName)
+	require.Equal(t, "", sr.Results[2].value("missing"))
+
+	// truncated on the way
+	sr = searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	err := scraper.unmarshallSearchReq(newResponse(http.StatusOK, `{"results":[{"indexname":"ma`), &sr)
+	require.ErrorIs(t, err, errCorruptSearchResponse)
+	require.Contains(t, err.Error(), `SplunkLicenseIndexUsageSearch`)
+
+	// rows that are not objects
+	sr = searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	err = scraper.unmarshallSearchReq(newResponse(http.StatusOK, `{"results":[["main","1024"]]}`), &sr)
+	require.ErrorIs(t, err, errCorruptSearchResponse)
+}
+
+// chunked responses report no length, empty bodies are told apart by reading them
+func TestUnmarshallSearchReqChunked(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config))
+	get := func() *http.Response {
+		res, err := http.Get(ts.URL)
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), res.ContentLength)
+		return res
+	}
+
+	res := get()
+	defer res.Body.Close()
+	sr := searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	require.NoError(t, scraper.unmarshallSearchReq(res, &sr))
+	require.Equal(t, http.StatusOK, sr.Return)
+	require.Empty(t, sr.Results)
+
+	body = `{"results":[{"indexname":"main"}]}`
+	res = get()
+	defer res.Body.Close()
+	sr = searchResponse{name: `SplunkLicenseIndexUsageSearch`}
+	require.NoError(t, scraper.unmarshallSearchReq(res, &sr))
+	require.Len(t, sr.Results, 1)
+	require.Equal(t, "main", sr.Results[0].value("indexname"))
+}
+
+// instances other than the cluster manager refuse the cluster manager endpoints
+func TestScrapeClusterMasterSkipped(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	// the server refuses everything, server info included
+	cfg.VerifyConnectionOnStart = false
+	cfg.MetricsBuilderConfig.Metrics.SplunkClusterIndexSearchable.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkClusterReplicationFactor.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkClusterSearchFactorMet.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeClusterMaster(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, 0, scraper.instances[0].mb.Emit().DataPointCount())
+}
+
+// indexers without SmartStore indexes don't serve the cache manager endpoint
+func TestScrapeSmartStoreCacheSkipped(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/admin/cacheman/_metrics" {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		mockServerInfo(w, r)
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics.SplunkSmartstoreCacheHitRatio.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkSmartstoreEvictionCount.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSmartStoreCache(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, 0, scraper.instances[0].mb.Emit().DataPointCount())
+}
+
+// nothing is reported while the results cache is turned off
+func TestScrapeSearchResultsCacheDisabled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/server/introspection/search/results_cache" {
+			_, _ = w.Write([]byte(`{"entry":[{"name":"results_cache","content":{"enabled":"0","hits":"0","misses":"57"}}]}`))
+			return
+		}
+		mockServerInfo(w, r)
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics.SplunkSearchCacheHitRatio.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSearchResultsCache(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, 0, scraper.instances[0].mb.Emit().DataPointCount())
+}
+
+// the age of the oldest triggered alert only counts the apps reported
+func TestScrapeFiredAlerts(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.Apps = []string{"search"}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkAlertsTriggeredCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkAlertsTriggeredOldestSeconds.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Unix(1694019600, 0))
+	scraper.instances[0].scrapeFiredAlerts(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		dp := metrics.At(i).Gauge().DataPoints().At(0)
+		switch metrics.At(i).Name() {
+		case "splunk.alerts.triggered.count":
+			require.Equal(t, int64(2), dp.IntValue())
+		case "splunk.alerts.triggered.oldest.seconds":
+			require.Equal(t, 7200.0, dp.DoubleValue())
+		default:
+			t.Fatalf("unexpected metric %s", metrics.At(i).Name())
+		}
+	}
+}
+
+// rows are parsed on their own, the unparseable second row only drops its own datapoint
+func TestScrapeLicenseUsageByIndexPartial(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeLicenseUsageByIndex(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.Error(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit()
+	require.Equal(t, 2, metrics.DataPointCount())
+	dps := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	for i, expected := range []struct {
+		index string
+		usage int64
+	}{{"_internal", 1048576}, {"main", 2048}} {
+		index, _ := dps.At(i).Attributes().Get("splunk.index.name")
+		require.Equal(t, expected.index, index.Str())
+		require.Equal(t, expected.usage, dps.At(i).IntValue())
+	}
+}
+
+// a custom search replaces the built-in search of its metric only
+func TestScrapeCustomSearch(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	const spl = `index=_internal sourcetype=scheduler status=skipped | stats count as skips by savedsearch_name`
+	custom := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs/":
+			_ = r.ParseForm()
+			if r.Form.Get("search") == "search "+spl {
+				w.WriteHeader(http.StatusCreated)
+				writeSearchResponse(w, r, `<response><sid>custom</sid></response>`)
+				return
+			}
+		case r.URL.Path == "/servicesNS/nobody/search/search/jobs/custom/results":
+			writeSearchResponse(w, r, `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>savedsearch_name</field><field>skips</field></fieldOrder></meta><result offset='0'><field k='savedsearch_name'><value><text>Errors in the last hour</text></value></field><field k='skips'><value><text>7</text></value></field></result></results>`)
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer custom.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = custom.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.SavedSearches = []string{"Errors in the last hour"}
+	cfg.CustomSearches = map[string]CustomSearch{
+		"splunk.scheduler.skipped.count": {Search: spl, Field: "skips"},
+	}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkSchedulerSkippedCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkSchedulerLagSeconds.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSchedulerMetrics(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		require.Equal(t, 1, m.Gauge().DataPoints().Len())
+		dp := m.Gauge().DataPoints().At(0)
+		switch m.Name() {
+		case "splunk.scheduler.skipped.count":
+			require.Equal(t, int64(7), dp.IntValue())
+		case "splunk.scheduler.lag.seconds":
+			// still from the built-in search
+			require.Equal(t, 12.5, dp.DoubleValue())
+		default:
+			t.Fatalf("unexpected metric %s", m.Name())
+		}
+	}
+}
+
+// a dispatch that failed after the job was created is retried under the same SID, and the job
+// the retry gets turned down for is read all the same
+func TestScrapeDispatchRetried(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	var sids []string
+	custom := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs/" {
+			_ = r.ParseForm()
+			sids = append(sids, r.Form.Get("id"))
+			if len(sids) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			writeSearchResponse(w, r, `<response><messages><msg type="FATAL">Search id already in use</msg></messages></response>`)
+			return
+		}
+		if len(sids) > 0 {
+			switch r.URL.Path {
+			case "/servicesNS/nobody/search/search/jobs/" + sids[0]:
+				_, _ = w.Write([]byte(`{"entry":[{"content":{"dispatchState":"DONE","isFailed":false}}]}`))
+				return
+			case "/servicesNS/nobody/search/search/jobs/" + sids[0] + "/results":
+				writeSearchResponse(w, r, `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>indexname</field><field>By</field></fieldOrder></meta><result offset='0'><field k='indexname'><value><text>main</text></value></field><field k='By'><value><text>1024</text></value></field></result></results>`)
+				return
+			}
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer custom.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = custom.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.RequestRetryBackoff = time.Millisecond
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeLicenseUsageByIndex(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	require.Len(t, sids, 2)
+	require.NotEmpty(t, sids[0])
+	require.Equal(t, sids[0], sids[1])
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	require.Equal(t, int64(1024), metrics.At(0).Gauge().DataPoints().At(0).IntValue())
+}
+
+// a custom search dispatched from a saved search instead of ad hoc
+func TestScrapeSavedSearchDispatch(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	custom := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/saved/searches/Scheduler skips/dispatch":
+			w.WriteHeader(http.StatusCreated)
+			writeSearchResponse(w, r, `<response><sid>saved</sid></response>`)
+			return
+		case r.URL.Path == "/servicesNS/nobody/search/search/jobs/saved/results":
+			writeSearchResponse(w, r, `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>savedsearch_name</field><field>skips</field></fieldOrder></meta><result offset='0'><field k='savedsearch_name'><value><text>Errors in the last hour</text></value></field><field k='skips'><value><text>3</text></value></field></result></results>`)
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer custom.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = custom.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.CustomSearches = map[string]CustomSearch{
+		"splunk.scheduler.skipped.count": {SavedSearchName: "Scheduler skips", Field: "skips"},
+	}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkSchedulerSkippedCount.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSchedulerMetrics(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	require.Equal(t, "splunk.scheduler.skipped.count", metrics.At(0).Name())
+	require.Equal(t, int64(3), metrics.At(0).Gauge().DataPoints().At(0).IntValue())
+}
+
+// license usage read from a summary index with field names of its own
+func TestScrapeLicenseFieldMapping(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	const spl = `index=license_summary | stats sum(bytes) as bytes by idx`
+	custom := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs/":
+			_ = r.ParseForm()
+			if r.Form.Get("search") == "search "+spl {
+				w.WriteHeader(http.StatusCreated)
+				writeSearchResponse(w, r, `<response><sid>summary</sid></response>`)
+				return
+			}
+		case r.URL.Path == "/servicesNS/nobody/search/search/jobs/summary/results":
+			writeSearchResponse(w, r, `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>idx</field><field>bytes</field></fieldOrder></meta><result offset='0'><field k='idx'><value><text>main</text></value></field><field k='bytes'><value><text>2048</text></value></field></result></results>`)
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer custom.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = custom.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.LicenseIndexField = "idx"
+	cfg.LicenseBytesField = "bytes"
+	cfg.CustomSearches = map[string]CustomSearch{
+		"splunk.license.index.usage": {Search: spl},
+	}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeLicenseUsageByIndex(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	dps := metrics.At(0).Gauge().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	require.Equal(t, int64(2048), dps.At(0).IntValue())
+	index, _ := dps.At(0).Attributes().Get("splunk.index.name")
+	require.Equal(t, "main", index.Str())
+}
+
+// license usage scoped to a few indexes by a clause ahead of the first pipe of the built-in search
+func TestScrapeLicenseUsageIndexes(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+	scoped := strings.Replace(searchDict[`SplunkLicenseIndexUsageSearch`], `type="Usage"|`, `type="Usage" idx IN ("main", "web*")|`, 1)
+	require.NotEqual(t, searchDict[`SplunkLicenseIndexUsageSearch`], scoped)
+	custom := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs/":
+			_ = r.ParseForm()
+			if r.Form.Get("search") == strings.TrimPrefix(scoped, "search=") {
+				w.WriteHeader(http.StatusCreated)
+				writeSearchResponse(w, r, `<response><sid>scoped</sid></response>`)
+				return
+			}
+		case r.URL.Path == "/servicesNS/nobody/search/search/jobs/scoped/results":
+			writeSearchResponse(w, r, `<?xml version='1.0' encoding='UTF-8'?><results preview='0'><meta><fieldOrder><field>indexname</field><field>By</field></fieldOrder></meta><result offset='0'><field k='indexname'><value><text>main</text></value></field><field k='By'><value><text>4096</text></value></field></result></results>`)
+			return
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer custom.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = custom.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.LicenseUsageIndexes = []string{"main", "web*"}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeLicenseUsageByIndex(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	dps := metrics.At(0).Gauge().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	require.Equal(t, int64(4096), dps.At(0).IntValue())
+}
+
+// the ingestion latency search aggregates with the configured statistic, avg unless set
+func TestIngestionLatencyStatistic(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	s := &instanceScraper{splunkScraper: &splunkScraper{conf: cfg}}
+	require.Equal(t, searchDict[`SplunkIngestionLatencySearch`], s.builtinSearch(`SplunkIngestionLatencySearch`))
+
+	cfg.IngestionLatencyStatistic = "p95"
+	search := s.builtinSearch(`SplunkIngestionLatencySearch`)
+	require.Contains(t, search, "| stats p95(latency) as latency by sourcetype")
+	require.NotContains(t, search, "avg(")
+	require.Equal(t, searchDict[`SplunkSourcetypeVolumeSearch`], s.builtinSearch(`SplunkSourcetypeVolumeSearch`))
+}
+
+// the per index search duration search covers the last 10 minutes unless a search time range is set
+func TestIndexSearchDurationWindow(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	s := &instanceScraper{splunkScraper: &splunkScraper{conf: cfg}}
+	require.Equal(t, searchDict[`SplunkIndexSearchDurationSearch`], s.builtinSearch(`SplunkIndexSearchDurationSearch`))
+	require.Contains(t, s.builtinSearch(`SplunkIndexSearchDurationSearch`), "earliest=-10m@m latest=@m|")
+
+	cfg.SearchEarliestTime = "-1h@h"
+	search := s.builtinSearch(`SplunkIndexSearchDurationSearch`)
+	require.NotContains(t, search, "earliest=")
+	require.Contains(t, search, "info=completed total_run_time=*| rex")
+}
+
+// with the Monitoring Console summaries in use, license usage and source type throughput come
+// from them rather than from the raw logs
+func TestScrapeMonitoringConsole(t *testing.T) {
+	var dispatched []string
+	var mux sync.Mutex
+	ts := createMockServer()
+	defer ts.Close()
+	recording := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs/" {
+			_ = r.ParseForm()
+			mux.Lock()
+			dispatched = append(dispatched, r.Form.Get("search"))
+			mux.Unlock()
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer recording.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = recording.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.UseMonitoringConsole = true
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexerThroughputBySourcetype.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		strings.TrimPrefix(searchDict[`SplunkMCLicenseUsageSearch`], "search="),
+		strings.TrimPrefix(searchDict[`SplunkMCThroughputSearch`], "search="),
+	}, dispatched)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		require.Equal(t, 1, m.Gauge().DataPoints().Len())
+		dp := m.Gauge().DataPoints().At(0)
+		switch m.Name() {
+		case "splunk.license.index.usage":
+			require.Equal(t, int64(8192), dp.IntValue())
+		case "splunk.indexer.throughput.by_sourcetype":
+			require.Equal(t, 2048.5, dp.DoubleValue())
+		default:
+			t.Fatalf("unexpected metric %s", m.Name())
+		}
+	}
+
+	// the summaries are read with tstats, which can't filter on idx so the indexes are picked after it
+	cfg.LicenseUsageIndexes = []string{"main", "web*"}
+	search := scraper.instances[0].builtinSearch(`SplunkMCLicenseUsageSearch`)
+	require.True(t, strings.HasPrefix(search, "search=| tstats "))
+	require.Contains(t, search, `| rename "idx=" as idx, "b=" as b| search idx IN ("main", "web*")| eval indexname=`)
+}
+
+// the captain reports replication jobs for every member, itself included
+func TestScrapeSHCReplication(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/services/shcluster/captain/info":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"captain","content":{"elected_captain":1690838000,"id":"3F2504E0-4F89-11D3-9A0C-0305E82C3301","label":"sh1"}}]}`))
+		case "/services/server/info":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"server-info","content":{"guid":"3F2504E0-4F89-11D3-9A0C-0305E82C3301","serverName":"sh1"}}]}`))
+		case "/services/shcluster/captain/members":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"3F2504E0-4F89-11D3-9A0C-0305E82C3301","content":{"label":"sh1","pending_job_count":0,"status":"Up"}},{"name":"0A6E3C1B-5C2D-4F5A-9C4B-2F8E7D6C5B4A","content":{"label":"sh2","pending_job_count":"7","status":"Up"}}],"paging":{"total":2,"perPage":30,"offset":0}}`))
+		case "/services/shcluster/captain/jobs":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"1","content":{"job_type":"artifact_replication","job_state":"failed","target":"0A6E3C1B-5C2D-4F5A-9C4B-2F8E7D6C5B4A"}},{"name":"2","content":{"job_type":"artifact_replication","job_state":"failed","target":"0A6E3C1B-5C2D-4F5A-9C4B-2F8E7D6C5B4A"}},{"name":"3","content":{"job_type":"artifact_replication","job_state":"running","target":"3F2504E0-4F89-11D3-9A0C-0305E82C3301"}},{"name":"4","content":{"job_type":"fixup","job_state":"failed","target":"3F2504E0-4F89-11D3-9A0C-0305E82C3301"}}],"paging":{"total":4,"perPage":30,"offset":0}}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkShcReplicationPendingCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkShcArtifactReplicationFailures.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSHCReplication(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	values := map[string]map[string]int64{}
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		values[m.Name()] = map[string]int64{}
+		for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+			dp := m.Gauge().DataPoints().At(j)
+			guid, _ := dp.Attributes().Get("splunk.shc.peer.guid")
+			values[m.Name()][guid.Str()] = dp.IntValue()
+		}
+	}
+	require.Equal(t, map[string]map[string]int64{
+		"splunk.shc.replication.pending.count": {
+			"3F2504E0-4F89-11D3-9A0C-0305E82C3301": 0,
+			"0A6E3C1B-5C2D-4F5A-9C4B-2F8E7D6C5B4A": 7,
+		},
+		"splunk.shc.artifact.replication.failures": {
+			"3F2504E0-4F89-11D3-9A0C-0305E82C3301": 0,
+			"0A6E3C1B-5C2D-4F5A-9C4B-2F8E7D6C5B4A": 2,
+		},
+	}, values)
+}
+
+// an overridden endpoint replaces the default one for every metric scraped from it
+func TestScrapeEndpointOverrides(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/server/status/partitions" {
+			mockPartitionsSpace(w, r)
+			return
+		}
+		http.NotFoundHandler().ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.EndpointOverrides = map[string]string{
+		"splunk.server.partition.used.bytes": "/services/server/status/partitions?output_mode=json",
+	}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkServerPartitionUsedBytes.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkServerPartitionFreeBytes.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	require.Equal(t, "/services/server/status/partitions?output_mode=json", scraper.api[`SplunkPartitionsSpace`])
+	require.Equal(t, apiDict[`SplunkServerInfo`], scraper.api[`SplunkServerInfo`])
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeDiskUsage(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		require.Equal(t, 2, metrics.At(i).Gauge().DataPoints().Len())
+	}
+}
+
+// an override without a query string of its own still pages through the entries
+func TestScrapeEndpointOverridesPaged(t *testing.T) {
+	var offsets []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/alerts/fired_alerts/-" {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		entry := `{"name":"scheduler__admin__search__RMD5errors_at_1694012400_` + offset + `","acl":{"app":"search","owner":"admin"},"content":{"savedsearch_name":"Errors in the last hour","severity":3,"trigger_time":1694012400}}`
+		if offset == "" {
+			offset = "0"
+		}
+		_, _ = w.Write([]byte(`{"entry":[` + entry + `],"paging":{"total":2,"perPage":1,"offset":` + offset + `}}`))
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.EndpointOverrides = map[string]string{
+		"splunk.alerts.triggered.count": "/services/alerts/fired_alerts/-",
+	}
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkAlertsTriggeredCount.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeFiredAlerts(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, []string{"", "1"}, offsets)
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	require.Equal(t, int64(2), metrics.At(0).Gauge().DataPoints().At(0).IntValue())
+}
+
+// the oldest queued search is waiting since its dispatch, and an empty queue has nothing waiting
+func TestScrapeOldestQueuedSearch(t *testing.T) {
+	jobs := `{"entry":[{"name":"1695901337.42","published":"2023-09-28T11:40:00.000+00:00","content":{"dispatchState":"RUNNING"}},{"name":"1695901338.43","published":"2023-09-28T11:41:30.000+00:00","content":{"dispatchState":"QUEUED"}},{"name":"1695901339.44","published":"2023-09-28T11:42:00.000+00:00","content":{"dispatchState":"QUEUED"}}],"paging":{"total":3,"perPage":30,"offset":0}}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/search/jobs" {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(jobs))
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkSchedulerOldestQueuedSeconds.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	oldestQueued := func() float64 {
+		errs := &scrapererror.ScrapeErrors{}
+		now := time.Date(2023, 9, 28, 11, 43, 0, 0, time.UTC)
+		scraper.instances[0].scrapeSearchConcurrency(context.Background(), pcommon.NewTimestampFromTime(now), errs)
+		require.NoError(t, errs.Combine())
+
+		metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 1, metrics.Len())
+		require.Equal(t, 1, metrics.At(0).Gauge().DataPoints().Len())
+		return metrics.At(0).Gauge().DataPoints().At(0).DoubleValue()
+	}
+
+	require.Equal(t, 90.0, oldestQueued())
+
+	jobs = `{"entry":[{"name":"1695901337.42","published":"2023-09-28T11:40:00.000+00:00","content":{"dispatchState":"RUNNING"}}],"paging":{"total":1,"perPage":30,"offset":0}}`
+	require.Equal(t, 0.0, oldestQueued())
+}
+
+// skips are recorded per reason they map to, adding up the reasons mapping to the same one
+func TestScrapeSchedulerSkipReasons(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkSchedulerSkippedCount.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeSchedulerMetrics(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	skips := make(map[string]int64)
+	dps := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		savedSearch, _ := dps.At(i).Attributes().Get("splunk.savedsearch.name")
+		reason, _ := dps.At(i).Attributes().Get("splunk.scheduler.skip.reason")
+		skips[savedSearch.Str()+"/"+reason.Str()] = dps.At(i).IntValue()
+	}
+	require.Equal(t, map[string]int64{
+		"Errors in the last hour/concurrency_limit": 3,
+		"Noisy report/other":                        4,
+		"Brute force attempts/other":                1,
+	}, skips)
+
+	require.Equal(t, "disabled", skipReason("The scheduled search was disabled while it was queued"))
+	require.Equal(t, "max_lag", skipReason("Search was delayed past its max lag"))
+}
+
+// indexes left out by the regexes get no datapoints but still count towards the number of indexes
+func TestScrapeIndexesFiltered(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.IndexIncludeRegex = "^(_internal|main)$"
+	cfg.IndexExcludeRegex = "^_"
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexEventCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexesCount.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.instances[0].scrapeIndexesExtended(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.instances[0].mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		dps := metrics.At(i).Gauge().DataPoints()
+		switch metrics.At(i).Name() {
+		case "splunk.index.event.count":
+			require.Equal(t, 1, dps.Len())
+			index, _ := dps.At(0).Attributes().Get("splunk.index.name")
+			require.Equal(t, "main", index.Str())
+		case "splunk.indexes.count":
+			var total int64
+			for j := 0; j < dps.Len(); j++ {
+				total += dps.At(j).IntValue()
+			}
+			require.Equal(t, int64(3), total)
+		default:
+			t.Fatalf("unexpected metric %s", metrics.At(i).Name())
+		}
+	}
+
+	// the regexes are compiled by start, which turns down one that doesn't compile
+	cfg.IndexIncludeRegex = "(main"
+	scraper = newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.ErrorIs(t, scraper.start(context.Background(), componenttest.NewNopHost()), errBadIndexRegex)
+}
+
+// the scheduler and the alert metrics are computed from a single dispatch of the scheduler search
+func TestScrapeSharedSearch(t *testing.T) {
+	var dispatches atomic.Int32
+	ts := createMockServer()
+	defer ts.Close()
+	counting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs/" {
+			dispatches.Add(1)
+		}
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer counting.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = counting.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkSchedulerLagSeconds.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkSavedsearchAlertFiredCount.Enabled = true
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	for i := 0; i < 2; i++ {
+		dispatches.Store(0)
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		// searches are shared within a scrape only
+		require.Equal(t, int32(1), dispatches.Load())
+
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 2, metrics.Len())
+		for j := 0; j < metrics.Len(); j++ {
+			switch m := metrics.At(j); m.Name() {
+			case "splunk.scheduler.lag.seconds":
+				require.Equal(t, 3, m.Gauge().DataPoints().Len())
+			case "splunk.savedsearch.alert.fired.count":
+				// the saved search without alert actions is left out
+				require.Equal(t, 2, m.Gauge().DataPoints().Len())
+			default:
+				t.Fatalf("unexpected metric %s", m.Name())
+			}
+		}
+	}
+}
+
+// without access to _internal bucket events are estimated from how the bucket counts change
+func TestScrapeBucketEventsAPI(t *testing.T) {
+	counts := []string{`"12", "3"`, `"15", "3"`, `"14", "2"`}
+	var scrapes int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/data/indexes" {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		c := strings.Split(counts[scrapes], ", ")
+		scrapes++
+		_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"bucket_dirs":{"home":{"hot_bucket_count":"2","warm_bucket_count":` + c[0] + `},"cold":{"bucket_count":` + c[1] + `}}}}],"paging":{"total":1,"perPage":30,"offset":0}}`))
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.BucketEventsSource = bucketEventsSourceAPI
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexBucketsRolledCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SplunkIndexBucketsFrozenCount.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	for _, expected := range []*bucketEvents{nil, {rolled: 3}, {frozen: 2}} {
+		errs := &scrapererror.ScrapeErrors{}
+		scraper.instances[0].scrapeBucketEvents(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+		require.NoError(t, errs.Combine())
+
+		md := scraper.instances[0].mb.Emit()
+		// the first scrape only takes note of the counts
+		if expected == nil {
+			require.Equal(t, 0, md.DataPointCount())
+			continue
+		}
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			dp := metrics.At(i).Gauge().DataPoints().At(0)
+			switch metrics.At(i).Name() {
+			case "splunk.index.buckets.rolled.count":
+				require.Equal(t, expected.rolled, dp.IntValue())
+			case "splunk.index.buckets.frozen.count":
+				require.Equal(t, expected.frozen, dp.IntValue())
+			}
+		}
+	}
+}
+
+// every form of event time reported by the indexes endpoint across Splunk versions
+func TestTimestampUnmarshal(t *testing.T) {
+	expected := time.Date(2023, 9, 28, 11, 42, 17, 0, time.UTC)
+
+	for _, raw := range []string{`"2023-09-28T11:42:17+00:00"`, `"2023-09-28T13:42:17.000+0200"`, `"1695901337"`, `1695901337`} {
+		var ts timestamp
+		require.NoError(t, json.Unmarshal([]byte(raw), &ts))
+		require.True(t, ts.ok, raw)
+		require.True(t, expected.Equal(ts.value), raw)
+	}
+
+	for _, raw := range []string{`""`, `null`, `"never"`} {
+		var ts timestamp
+		require.NoError(t, json.Unmarshal([]byte(raw), &ts))
+		require.False(t, ts.ok, raw)
+	}
+}
+
+func TestSearchBackoff(t *testing.T) {
+	backoff := newSearchBackoff(200*time.Millisecond, 2*time.Second)
+
+	// each wait is the current interval minus at most half of it, intervals double up to the max
+	expected := []time.Duration{
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		1600 * time.Millisecond,
+		2 * time.Second,
+		2 * time.Second,
+	}
+
+	for _, interval := range expected {
+		wait := backoff.next()
+		require.LessOrEqual(t, wait, interval)
+		require.GreaterOrEqual(t, wait, interval/2)
+	}
+
+	// initial intervals above the max are clamped
+	backoff = newSearchBackoff(time.Minute, time.Second)
+	require.LessOrEqual(t, backoff.next(), time.Second)
+}
+
+func TestPollSearchJob(t *testing.T) {
+	var polls, deletes int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/servicesNS/nobody/search/search/jobs/":
+			w.WriteHeader(http.StatusCreated)
+			writeSearchResponse(w, r, `<response><sid>1234</sid></response>`)
+		case "/servicesNS/nobody/search/search/jobs/1234":
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`{"entry":[{"name":"1234","content":{"dispatchState":"RUNNING","isFailed":false,"messages":[]}}]}`))
+				return
+			}
+			require.Equal(t, http.MethodDelete, r.Method)
+			deletes++
+		case "/servicesNS/nobody/search/search/jobs/1234/results":
+			// job is still running for the first couple of polls
+			polls++
+			if polls < 3 {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			writeSearchResponse(w, r, `<results><result><field k="indexname"><value><text>main</text></value></field><field k="By"><value><text>1024</text></value></field></result></results>`)
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MaxSearchPollInterval = 10 * time.Millisecond
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	sr := searchResponse{search: "search=search index=_internal"}
+	require.NoError(t, scraper.instances[0].pollSearchJob(context.Background(), pcommon.NewTimestampFromTime(time.Now()), &sr))
+	require.Equal(t, 3, polls)
+	require.Equal(t, "1234", *sr.Jobid)
+	require.Len(t, sr.Results, 1)
+	require.Equal(t, "main", sr.Results[0].value("indexname"))
+	require.Equal(t, 1, deletes)
+
+	// jobs that never finish are abandoned once MaxSearchWaitTime runs out
+	polls = -1000
+	cfg.MaxSearchWaitTime = 50 * time.Millisecond
+	sr = searchResponse{name: "SplunkLicenseIndexUsageSearch", search: "search=search index=_internal"}
+	require.ErrorIs(t, scraper.instances[0].pollSearchJob(context.Background(), pcommon.NewTimestampFromTime(time.Now()), &sr), errMaxSearchWaitTimeExceeded)
+	// abandoned jobs are cleaned up as well
+	require.Equal(t, 2, deletes)
+	require.Equal(t, map[string]int64{"SplunkLicenseIndexUsageSearch": 1}, scraper.instances[0].searchTimeouts)
+
+	// cancelling the scrape stops the polling right away
+	polls = -1000
+	cfg.MaxSearchWaitTime = time.Minute
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	sr = searchResponse{search: "search=search index=_internal"}
+	require.ErrorIs(t, scraper.instances[0].pollSearchJob(ctx, pcommon.NewTimestampFromTime(time.Now()), &sr), context.Canceled)
+	require.Less(t, time.Since(start), time.Second)
+	// a cancelled search did not time out
+	require.Len(t, scraper.instances[0].searchTimeouts, 1)
+}
+
+func TestPollSearchJobFailed(t *testing.T) {
+	var deletes int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/servicesNS/nobody/search/search/jobs/":
+			w.WriteHeader(http.StatusCreated)
+			writeSearchResponse(w, r, `<response><sid>1234</sid></response>`)
+		case "/servicesNS/nobody/search/search/jobs/1234":
+			if r.Method == http.MethodDelete {
+				deletes++
+				return
+			}
+			_, _ = w.Write([]byte(`{"entry":[{"name":"1234","content":{"dispatchState":"FAILED","isFailed":true,"messages":[{"type":"FATAL","text":"Error in 'stats' command: The argument 'sum(b' is invalid."}]}}]}`))
+		case "/servicesNS/nobody/search/search/jobs/1234/results":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// the failure is reported right away instead of once MaxSearchWaitTime runs out
+	start := time.Now()
+	sr := searchResponse{name: "broken", search: "search=search index=_internal | stats sum(b"}
+	err := scraper.instances[0].pollSearchJob(context.Background(), pcommon.NewTimestampFromTime(time.Now()), &sr)
+	require.ErrorIs(t, err, errSearchJobFailed)
+	require.ErrorContains(t, err, "FAILED")
+	require.ErrorContains(t, err, "Error in 'stats' command")
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, 1, deletes)
+}
+
+// a search the search head turns down, e.g. for a syntax error, fails with its messages right away
+// instead of being dispatched again until MaxSearchWaitTime runs out
+func TestPollSearchJobRejected(t *testing.T) {
+	for _, mode := range []string{searchOutputModeXML, searchOutputModeJSON} {
+		t.Run(mode, func(t *testing.T) {
+			var requests int
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(http.StatusBadRequest)
+				if mode == searchOutputModeJSON {
+					_, _ = w.Write([]byte(`{"messages":[{"type":"FATAL","text":"Error in 'stats' command: The argument 'sum(b' is invalid."}]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`<response><messages><msg type="FATAL">Error in 'stats' command: The argument 'sum(b' is invalid.</msg></messages></response>`))
+			}))
+			defer ts.Close()
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = ts.URL
+			cfg.Username = "admin"
+			cfg.Password = "securityFirst"
+			cfg.SessionKeyTTL = 0
+			cfg.SearchOutputMode = mode
+
+			scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+			// start verified the connection
+			requests = 0
+
+			start := time.Now()
+			sr := searchResponse{name: "broken", search: customSearchBody("index=_internal | stats sum(b")}
+			err := scraper.instances[0].pollSearchJob(context.Background(), pcommon.NewTimestampFromTime(time.Now()), &sr)
+			require.ErrorIs(t, err, errSearchDispatchRejected)
+			require.ErrorContains(t, err, "Bad Request")
+			require.ErrorContains(t, err, "FATAL: Error in 'stats' command")
+			require.Less(t, time.Since(start), time.Second)
+			require.Equal(t, 1, requests)
+		})
+	}
+}
+
+// a dispatch failing on a 5xx is looked up once per attempt, however often it is retried within it
+func TestPollSearchJobDispatchUnavailable(t *testing.T) {
+	var dispatches, lookups int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/servicesNS/nobody/search/search/jobs/":
+			dispatches++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case strings.HasPrefix(r.URL.Path, "/servicesNS/nobody/search/search/jobs/otel_"):
+			lookups++
+			http.NotFoundHandler().ServeHTTP(w, r)
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.MaxRequestRetries = 1
+	cfg.RequestRetryBackoff = time.Millisecond
+	cfg.SearchPollInterval = 10 * time.Millisecond
+	cfg.MaxSearchWaitTime = 100 * time.Millisecond
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	sr := searchResponse{name: "unavailable", search: "search=search index=_internal"}
+	err := scraper.instances[0].pollSearchJob(context.Background(), pcommon.NewTimestampFromTime(time.Now()), &sr)
+	require.ErrorIs(t, err, errMaxSearchWaitTimeExceeded)
+	require.Positive(t, lookups)
+	require.Equal(t, 2*lookups, dispatches)
+	// failed dispatches back off like polls do instead of going again right away
+	require.LessOrEqual(t, dispatches, 12)
+}
+
+// results spanning more than a page are read a page at a time until a page comes back short
+func TestPollSearchJobResultsTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/servicesNS/nobody/search/search/jobs/":
+			w.WriteHeader(http.StatusCreated)
+			writeSearchResponse(w, r, `<response><sid>1234</sid></response>`)
+		case "/servicesNS/nobody/search/search/jobs/1234/results":
+			// the job is done but its results trickle in, then stall
+			_, _ = w.Write([]byte(`{"preview":false,"init_offset":0,"results":[{`))
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+	cfg.ResultsReadTimeout = 50 * time.Millisecond
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	start := time.Now()
+	sr := searchResponse{search: "search=search index=_internal"}
+	err := scraper.instances[0].pollSearchJob(context.Background(), pcommon.NewTimestampFromTime(time.Now()), &sr)
+	require.ErrorIs(t, err, errResultsReadTimeout)
+	require.Less(t, time.Since(start), cfg.MaxSearchWaitTime)
+}
+
+func TestPollSearchJobPages(t *testing.T) {
+	var offsets []string
+
+	row := func(i int) string {
+		return fmt.Sprintf(`<result><field k="indexname"><value><text>index%d</text></value></field><field k="By"><value><text>%d</text></value></field></result>`, i, i)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/servicesNS/nobody/search/search/jobs/":
+			w.WriteHeader(http.StatusCreated)
+			writeSearchResponse(w, r, `<response><sid>1234</sid></response>`)
+		case "/servicesNS/nobody/search/search/jobs/1234":
+		case "/servicesNS/nobody/search/search/jobs/1234/results":
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+			offsets = append(offsets, r.URL.Query().Get("offset"))
+			var b strings.Builder
+			b.WriteString(`<results preview='0'>`)
+			for i := offset; i < offset+count && i < searchResultsPageSize+2; i++ {
+				b.WriteString(row(i))
+			}
+			b.WriteString(`</results>`)
+			writeSearchResponse(w, r, b.String())
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Username = "admin"
+	cfg.Password = "securityFirst"
+	cfg.SessionKeyTTL = 0
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	sr := searchResponse{search: "search=search index=_internal"}
+	require.NoError(t, scraper.instances[0].pollSearchJob(context.Background(), pcommon.NewTimestampFromTime(time.Now()), &sr))
+	require.Equal(t, []string{"0", strconv.Itoa(searchResultsPageSize)}, offsets)
+	require.Len(t, sr.Results, searchResultsPageSize+2)
+	require.Equal(t, "index0", sr.Results[0].value("indexname"))
+	require.Equal(t, fmt.Sprintf("index%d", searchResultsPageSize+1), sr.Results[searchResultsPageSize+1].value("indexname"))
 }
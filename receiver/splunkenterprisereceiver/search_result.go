@@ -3,20 +3,169 @@
 
 package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // metric name and its associated search as a key value pair
 var searchDict = map[string]string{
-	`SplunkLicenseIndexUsageSearch`: `search=search index=_internal source=*license_usage.log type="Usage"| fields idx, b| eval indexname = if(len(idx)=0 OR isnull(idx),"(UNKNOWN)",idx)| stats sum(b) as b by indexname| eval By=round(b, 9)| fields indexname, By`,
+	`SplunkLicenseIndexUsageSearch`:    `search=search index=_internal source=*license_usage.log type="Usage"| fields idx, b| eval indexname = if(len(idx)=0 OR isnull(idx),"(UNKNOWN)",idx)| stats sum(b) as b by indexname| eval By=round(b, 9)| fields indexname, By`,
+	`SplunkSourcetypeThroughputSearch`: `search=search index=_internal source=*metrics.log group=per_sourcetype_thruput earliest=-10m@m latest=@m| stats avg(kbps) as kbps by series| eval Bps=round(kbps*1000, 3)| rename series as sourcetype| fields sourcetype, Bps`,
+	`SplunkHECSearch`:                  `search=search index=_introspection sourcetype=http_event_collector_metrics data.series="http_event_collector_token" earliest=-10m@m latest=@m| stats sum(data.total_bytes_received) as bytes, sum(data.num_of_requests) as requests, sum(data.num_of_errors) as errors by data.token_name| rename data.token_name as token_name| fillnull value=0 bytes, requests, errors| fields token_name, bytes, requests, errors`,
+	`SplunkBucketEventsSearch`:         `search=search index=_internal sourcetype=splunkd earliest=-10m@m latest=@m ((component=HotBucketRoller "finished moving hot to warm") OR (component=BucketMover "will attempt to freeze"))| rex field=candidate "/(?<frozen_idx>[^/]*)/(?:db|colddb)/"| eval index_name=coalesce(idx, frozen_idx)| stats count(eval(component="HotBucketRoller")) as rolled, count(eval(component="BucketMover")) as frozen by index_name| fields index_name, rolled, frozen`,
+	`SplunkSchedulerSearch`:            `search=search index=_internal sourcetype=scheduler earliest=-10m@m latest=@m| eval lag=dispatch_time-scheduled_time, alerting=if(isnotnull(alert_actions), 1, 0)| stats avg(lag) as lag, avg(run_time) as run_time, max(alerting) as alerting, sum(fired) as fired, sum(suppressed) as suppressed by savedsearch_name| fillnull value=0 lag, run_time, fired, suppressed| eval fired=if(alerting=1, fired, null()), suppressed=if(alerting=1, suppressed, null())| fields savedsearch_name, lag, run_time, fired, suppressed`,
+	`SplunkSchedulerSkipsSearch`:       `search=search index=_internal sourcetype=scheduler status=skipped earliest=-10m@m latest=@m| fillnull value="" reason| stats count as skipped by savedsearch_name, reason| fields savedsearch_name, reason, skipped`,
+	`SplunkMCLicenseUsageSearch`:       `search=| tstats fillnull_value="" count where index=summary source="splunk_license_usage_by_index" earliest=-1d@d by PREFIX(idx=), PREFIX(b=)| rename "idx=" as idx, "b=" as b| eval indexname=if(len(idx)=0,"(UNKNOWN)",idx), b=b*count| stats sum(b) as b by indexname| eval By=round(b, 9)| fields indexname, By`,
+	`SplunkMCThroughputSearch`:         `search=| tstats count where index=summary source="splunk_sourcetype_throughput" earliest=-10m@m latest=@m by PREFIX(series=), PREFIX(kbps=)| rename "series=" as series, "kbps=" as kbps| stats sum(eval(kbps*count)) as kbps, sum(count) as samples by series| eval Bps=round(kbps/samples*1000, 3)| rename series as sourcetype| fields sourcetype, Bps`,
+	`SplunkPipelineCPUSearch`:          `search=search index=_internal source=*metrics.log group=pipeline earliest=-10m@m latest=@m| stats sum(cpu_seconds) as cpu_seconds by name, processor| rename name as pipeline| fields pipeline, processor, cpu_seconds`,
+	`SplunkSourcetypeVolumeSearch`:     `search=| tstats count where index=* by sourcetype| fields sourcetype, count`,
+	`SplunkIndexWriteRateSearch`:       `search=search index=_internal source=*metrics.log group=per_index_thruput earliest=-10m@m latest=@m| stats sum(ev) as events by series| eval rate=round(events/600, 3)| rename series as index_name| fields index_name, rate`,
+	`SplunkIndexSearchRateSearch`:      `search=search index=_audit action=search info=completed earliest=-10m@m latest=@m| rex field=search max_match=0 "index\s*=\s*\"?(?<index_name>[\w*-]*)"| mvexpand index_name| stats sum(scan_count) as events by index_name| eval rate=round(events/600, 3)| fields index_name, rate`,
+	`SplunkIndexSearchDurationSearch`:  `search=search index=_audit action=search info=completed total_run_time=* earliest=-10m@m latest=@m| rex field=search max_match=0 "index\s*=\s*\"?(?<index_name>[\w*-]*)"| mvexpand index_name| stats avg(total_run_time) as duration by index_name| eval duration=round(duration, 3)| fields index_name, duration`,
+	`SplunkIngestionLatencySearch`:     `search=| tstats max(_indextime) as indextime where index=* earliest=-24h _index_earliest=-10m@m _index_latest=@m by _time, sourcetype span=1s| eval latency=indextime-_time| stats avg(latency) as latency by sourcetype| fields sourcetype, latency`,
+	`SplunkSearchesByAppSearch`:        `search=search index=_introspection sourcetype=splunk_resource_usage component=PerProcess data.search_props.sid=* earliest=-10m@m latest=@m| stats dc(data.search_props.sid) as searches by data.search_props.app| rename data.search_props.app as app| fields app, searches`,
+	`SplunkForwarderConnectionsSearch`: `search=search index=_internal source=*metrics.log group=tcpin_connections earliest=-10m@m latest=@m| stats dc(sourcePort) as connections, sum(kb) as kb by guid| eval bytes=round(kb*1000, 0)| rename guid as forwarder_guid| fields forwarder_guid, connections, bytes`,
+}
+
+// A metric computed from the results of a search: the key of the search in searchDict, the field
+// of the results holding the value of the metric and the field holding the attribute it is
+// recorded for
+type searchMetric struct {
+	search    string
+	field     string
+	attribute string
+}
+
+// Every search based metric, any of which can be computed from a custom search instead. Metrics
+// of different scrape functions pointing at the same search share a single dispatch of it per
+// scrape, so a metric opts into an existing search by adding its field to it and pointing here
+var searchMetrics = map[string]searchMetric{
+	"splunk.license.index.usage":                {`SplunkLicenseIndexUsageSearch`, "By", "indexname"},
+	"splunk.indexer.throughput.by_sourcetype":   {`SplunkSourcetypeThroughputSearch`, "Bps", "sourcetype"},
+	"splunk.hec.data.received.bytes":            {`SplunkHECSearch`, "bytes", "token_name"},
+	"splunk.hec.requests.count":                 {`SplunkHECSearch`, "requests", "token_name"},
+	"splunk.hec.errors.count":                   {`SplunkHECSearch`, "errors", "token_name"},
+	"splunk.scheduler.skipped.count":            {`SplunkSchedulerSkipsSearch`, "skipped", "savedsearch_name"},
+	"splunk.scheduler.lag.seconds":              {`SplunkSchedulerSearch`, "lag", "savedsearch_name"},
+	"splunk.scheduler.execution.duration":       {`SplunkSchedulerSearch`, "run_time", "savedsearch_name"},
+	"splunk.savedsearch.alert.fired.count":      {`SplunkSchedulerSearch`, "fired", "savedsearch_name"},
+	"splunk.savedsearch.alert.suppressed.count": {`SplunkSchedulerSearch`, "suppressed", "savedsearch_name"},
+	"splunk.forwarder.connections.count":        {`SplunkForwarderConnectionsSearch`, "connections", "forwarder_guid"},
+	"splunk.forwarder.data.received.bytes":      {`SplunkForwarderConnectionsSearch`, "bytes", "forwarder_guid"},
+	"splunk.sourcetype.event.count":             {`SplunkSourcetypeVolumeSearch`, "count", "sourcetype"},
+	"splunk.indexer.ingestion.latency.seconds":  {`SplunkIngestionLatencySearch`, "latency", "sourcetype"},
+	"splunk.index.events.written.rate":          {`SplunkIndexWriteRateSearch`, "rate", "index_name"},
+	"splunk.index.events.searched.rate":         {`SplunkIndexSearchRateSearch`, "rate", "index_name"},
+	"splunk.index.search.duration.seconds":      {`SplunkIndexSearchDurationSearch`, "duration", "index_name"},
+	"splunk.search.count":                       {`SplunkSearchesByAppSearch`, "searches", "app"},
+}
+
+// Fields of the search results holding a second attribute of the search based metrics recorded for
+// two, keyed by metric name
+var searchMetricBreakdowns = map[string]string{
+	"splunk.scheduler.skipped.count": "reason",
+}
+
+// Reasons the scheduler gives for skipping a search, matched by a phrase of the message it logs,
+// and the value of the splunk.scheduler.skip.reason attribute they are recorded under. Checked in
+// order, any other reason is recorded as other
+var skipReasons = []struct {
+	phrase string
+	reason string
+}{
+	{"concurren", "concurrency_limit"},
+	{"disabled", "disabled"},
+	{"lag", "max_lag"},
+}
+
+// The value of the splunk.scheduler.skip.reason attribute for the reason the scheduler logged
+func skipReason(logged string) string {
+	logged = strings.ToLower(logged)
+	for _, r := range skipReasons {
+		if strings.Contains(logged, r.phrase) {
+			return r.reason
+		}
+	}
+	return "other"
+}
+
+// Built-in searches reading the pre-aggregated summaries kept for the Monitoring Console in place
+// of the raw logs, keyed by the metric computed from them. Used with Config.UseMonitoringConsole
+var monitoringConsoleSearches = map[string]string{
+	"splunk.license.index.usage":              `SplunkMCLicenseUsageSearch`,
+	"splunk.indexer.throughput.by_sourcetype": `SplunkMCThroughputSearch`,
 }
 
 var apiDict = map[string]string{
-	`SplunkIndexerThroughput`: `/services/server/introspection/indexer?output_mode=json`,
+	`SplunkIndexerThroughput`:  `/services/server/introspection/indexer?output_mode=json`,
+	`SplunkIndexerQueueRatio`:  `/services/server/introspection/queues?output_mode=json`,
+	`SplunkKVStoreStatus`:      `/services/kvstore/status?output_mode=json`,
+	`SplunkKVStoreCollections`: `/services/server/introspection/kvstore/collectionstats?output_mode=json&count=0`,
+	`SplunkIndexesExtended`:    `/services/data/indexes?output_mode=json&count=0`,
+	`SplunkSHCMemberInfo`:      `/services/shcluster/member/info?output_mode=json`,
+	`SplunkSHCCaptainInfo`:     `/services/shcluster/captain/info?output_mode=json`,
+	`SplunkSHCCaptainMembers`:  `/services/shcluster/captain/members?output_mode=json&count=0`,
+	`SplunkSHCCaptainJobs`:     `/services/shcluster/captain/jobs?output_mode=json&count=0`,
+	`SplunkServerInfo`:         `/services/server/info?output_mode=json`,
+	`SplunkDeploymentClients`:  `/services/deployment/server/clients?output_mode=json&count=0`,
+	`SplunkHostwideUsage`:      `/services/server/status/resource-usage/hostwide?output_mode=json`,
+	`SplunkProcessUsage`:       `/services/server/status/resource-usage/splunk-processes?output_mode=json&count=0`,
+	`SplunkPartitionsSpace`:    `/services/server/status/partitions-space?output_mode=json&count=0`,
+	`SplunkClusterGeneration`:  `/services/cluster/master/generation?output_mode=json`,
+	`SplunkClusterIndexes`:     `/services/cluster/master/indexes?output_mode=json&count=0`,
+	`SplunkClusterPeers`:       `/services/cluster/master/peers?output_mode=json&count=0`,
+	`SplunkClusterConfig`:      `/services/cluster/config?output_mode=json`,
+	`SplunkLicensePools`:       `/services/licenser/pools?output_mode=json&count=0`,
+	`SplunkLicenseSlaves`:      `/services/licenser/slaves?output_mode=json&count=0`,
+	`SplunkLicenseMessages`:    `/services/licenser/messages?output_mode=json&count=0`,
+	`SplunkHECTokens`:          `/services/data/inputs/http?output_mode=json&count=0`,
+	`SplunkActiveSearchJobs`:   `/services/search/jobs?output_mode=json&count=0&f=dispatchState&f=isRealTimeSearch&search=isDone%3D0`,
+	`SplunkSearchConcurrency`:  `/services/server/status/limits/search-concurrency?output_mode=json`,
+	`SplunkDataModelSummaries`: `/services/admin/summarization?by_tstats=t&output_mode=json&count=0`,
+	`SplunkDispatchArtifacts`:  `/services/search/jobs?output_mode=json&count=0&f=diskUsage`,
+	`SplunkUsers`:              `/services/authentication/users?output_mode=json&count=0`,
+	`SplunkRoles`:              `/services/authorization/roles?output_mode=json&count=0`,
+	`SplunkCacheManager`:       `/services/admin/cacheman/_metrics?output_mode=json&count=0`,
+	`SplunkSearchResultsCache`: `/services/server/introspection/search/results_cache?output_mode=json`,
+	`SplunkFiredAlerts`:        `/services/alerts/fired_alerts/-?output_mode=json&count=0`,
 }
 
 type searchResponse struct {
+	// key of the search in searchDict, or name of the metric a custom search is run for
+	name   string
 	search string
+	// name of the saved search dispatched in place of search
+	savedSearch string
+	// SID an ad hoc search is dispatched under, kept across retries of the dispatch
+	sid    string
 	Jobid  *string `xml:"sid"`
 	Return int
-	Fields []*field `xml:"result>field"`
+	// one entry per row of the search's results
+	Results []searchResult `xml:"result"`
+	// why the search head turned the request down, if it did
+	Messages []searchJobMessage `xml:"messages>msg"`
+	// number of rows read from the pages of results requested so far
+	offset int
+}
+
+type searchResult struct {
+	Fields []*field `xml:"field"`
+}
+
+// Value of the named field of the row, empty when the row doesn't have it
+func (r *searchResult) value(name string) string {
+	for _, f := range r.Fields {
+		if f.FieldName == name {
+			return f.Value
+		}
+	}
+	return ""
 }
 
 type field struct {
@@ -24,6 +173,168 @@ type field struct {
 	Value     string `xml:"value>text"`
 }
 
+// Response of a search read as JSON, the sid of its dispatch or a page of its results
+type searchResponseJSON struct {
+	Jobid    *string            `json:"sid"`
+	Results  []searchResult     `json:"results"`
+	Messages []searchJobMessage `json:"messages"`
+}
+
+// Reads a row written as an object of field names to values, keeping the fields in the order
+// Splunk wrote them in. Of the array a multivalue field is written as only the first value is kept
+func (r *searchResult) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if t, err := dec.Token(); err != nil {
+		return err
+	} else if t != json.Delim('{') {
+		return fmt.Errorf("search result row is not an object: %s", b)
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name, _ := t.(string)
+
+		var raw json.RawMessage
+		if err = dec.Decode(&raw); err != nil {
+			return err
+		}
+		f := &field{FieldName: name}
+		if err = json.Unmarshal(raw, &f.Value); err != nil {
+			var values []string
+			if json.Unmarshal(raw, &values) != nil {
+				return fmt.Errorf("search result field %s: %w", name, err)
+			}
+			if len(values) > 0 {
+				f.Value = values[0]
+			}
+		}
+		r.Fields = append(r.Fields, f)
+	}
+
+	_, err := dec.Token()
+	return err
+}
+
+// A number reported by an endpoint that may serialize it either as a JSON number or as a
+// string depending on the Splunk version. Booleans are read as 1 and 0. Values that are
+// missing, null or not numbers at all leave it unset rather than failing the whole response
+type numeric struct {
+	value float64
+	ok    bool
+}
+
+func (n *numeric) UnmarshalJSON(b []byte) error {
+	raw := strings.Trim(string(b), `"`)
+	switch raw {
+	case "true":
+		n.value, n.ok = 1, true
+		return nil
+	case "false":
+		n.value, n.ok = 0, true
+		return nil
+	}
+
+	// idle indexers have been seen reporting NaN and Inf, which are as good as no value
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	n.value, n.ok = v, true
+	return nil
+}
+
+// The maxHotBuckets setting of an index, either a number or one of the automatic settings standing
+// for a fixed number of hot buckets
+type maxHotBuckets struct {
+	numeric
+}
+
+func (m *maxHotBuckets) UnmarshalJSON(b []byte) error {
+	switch strings.Trim(string(b), `"`) {
+	case "auto":
+		m.value, m.ok = 3, true
+		return nil
+	case "auto_high_volume":
+		m.value, m.ok = 10, true
+		return nil
+	}
+	return m.numeric.UnmarshalJSON(b)
+}
+
+// Layouts of the timestamps reported by the REST API, older versions omit the colon of the offset
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05-0700",
+}
+
+// A point in time reported by an endpoint, either as a timestamp or, depending on the Splunk
+// version, as epoch seconds in a JSON number or a string. Like numeric, anything else, e.g. the
+// empty string reported for an index without events, leaves it unset
+type timestamp struct {
+	value time.Time
+	ok    bool
+}
+
+func (t *timestamp) UnmarshalJSON(b []byte) error {
+	raw := strings.Trim(string(b), `"`)
+
+	if epoch, err := strconv.ParseFloat(raw, 64); err == nil {
+		sec, frac := math.Modf(epoch)
+		t.value, t.ok = time.Unix(int64(sec), int64(frac*1e9)), true
+		return nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if v, err := time.Parse(layout, raw); err == nil {
+			t.value, t.ok = v, true
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// paging block included in REST API responses that list entries
+type paging struct {
+	Total   int `json:"total"`
+	PerPage int `json:"perPage"`
+	Offset  int `json:"offset"`
+}
+
+// '/services/search/jobs/<sid>'
+type searchJob struct {
+	Entries []searchJobEntry `json:"entry"`
+}
+
+type searchJobEntry struct {
+	Content searchJobContent `json:"content"`
+}
+
+// runDuration is reported in seconds
+type searchJobContent struct {
+	ScanCount     float64            `json:"scanCount"`
+	EventCount    float64            `json:"eventCount"`
+	RunDuration   float64            `json:"runDuration"`
+	DispatchState string             `json:"dispatchState"`
+	IsFailed      bool               `json:"isFailed"`
+	Messages      []searchJobMessage `json:"messages"`
+}
+
+// Messages the search head attached to a job, e.g. why it failed
+type searchJobMessage struct {
+	Type string `json:"type" xml:"type,attr"`
+	Text string `json:"text" xml:",chardata"`
+}
+
+// '/services/auth/login'
+type loginResponse struct {
+	SessionKey string `xml:"sessionKey"`
+}
+
 // '/services/server/introspection/indexer'
 type indexThroughput struct {
 	Entries []idxTEntry `json:"entry"`
@@ -33,7 +344,564 @@ type idxTEntry struct {
 	Content idxTContent `json:"content"`
 }
 
+// The introspection endpoint only breaks throughput down by status. Throughput per source type
+// is not exposed by any endpoint, so splunk.indexer.throughput.by_sourcetype is computed by
+// the SplunkSourcetypeThroughputSearch search over metrics.log instead
 type idxTContent struct {
 	Status string  `json:"status"`
-	AvgKb  float64 `json:"average_KBps"`
+	AvgKb  numeric `json:"average_KBps"`
+}
+
+// '/services/server/introspection/queues'
+type indexerQueues struct {
+	Entries []idxQEntry `json:"entry"`
+}
+
+type idxQEntry struct {
+	Name    string      `json:"name"`
+	Content idxQContent `json:"content"`
+}
+
+type idxQContent struct {
+	CurrentSizeBytes float64 `json:"current_size_bytes"`
+	MaxSizeBytes     float64 `json:"max_size_bytes"`
+}
+
+// '/services/kvstore/status'
+type kvStoreStatus struct {
+	Entries []kvEntry `json:"entry"`
+}
+
+type kvEntry struct {
+	Content kvContent `json:"content"`
+}
+
+type kvContent struct {
+	Current kvCurrent `json:"current"`
+}
+
+// replicationStatus is only reported by members of a search head cluster, any of these
+// can be missing so an empty value means the status is unknown
+type kvCurrent struct {
+	Status              string `json:"status"`
+	ReplicationStatus   string `json:"replicationStatus"`
+	BackupRestoreStatus string `json:"backupRestoreStatus"`
+}
+
+// '/services/server/introspection/kvstore/collectionstats', data holds the collStats document of
+// every collection of every app
+type kvCollectionStats struct {
+	Entries []kvCSEntry `json:"entry"`
+	Paging  paging      `json:"paging"`
+}
+
+type kvCSEntry struct {
+	Content struct {
+		Data []kvCollectionStat `json:"data"`
+	} `json:"content"`
+}
+
+// ns is the namespace of the collection, <app>.<collection>. size is the uncompressed size of
+// its records in bytes and count the number of records
+type kvCollectionStat struct {
+	NS    string  `json:"ns"`
+	Size  numeric `json:"size"`
+	Count numeric `json:"count"`
+}
+
+// The documents are serialized into strings rather than nested into the response
+func (k *kvCollectionStat) UnmarshalJSON(b []byte) error {
+	type stat kvCollectionStat
+	if len(b) > 0 && b[0] == '"' {
+		var doc string
+		if err := json.Unmarshal(b, &doc); err != nil {
+			return err
+		}
+		b = []byte(doc)
+	}
+	return json.Unmarshal(b, (*stat)(k))
+}
+
+// '/services/data/indexes'
+type indexesExtended struct {
+	Entries []idxEEntry `json:"entry"`
+	Paging  paging      `json:"paging"`
+}
+
+type idxEEntry struct {
+	Name    string      `json:"name"`
+	Content idxEContent `json:"content"`
+}
+
+// total_raw_size is reported in MB. minTime and maxTime are the times of the earliest and
+// latest events held by the index
+type idxEContent struct {
+	TotalBucketCount float64        `json:"total_bucket_count"`
+	TotalEventCount  float64        `json:"totalEventCount"`
+	TotalRawSizeMB   float64        `json:"total_raw_size"`
+	MinTime          timestamp      `json:"minTime"`
+	MaxTime          timestamp      `json:"maxTime"`
+	BucketDirs       idxEBucketDirs `json:"bucket_dirs"`
+	MaxHotBuckets    maxHotBuckets  `json:"maxHotBuckets"`
+	Datatype         string         `json:"datatype"`
+	// retention settings, how old and how large the data of the index gets before being frozen
+	FrozenTimePeriodInSecs numeric `json:"frozenTimePeriodInSecs"`
+	MaxTotalDataSizeMB     numeric `json:"maxTotalDataSizeMB"`
+}
+
+// buckets of the index by the directory holding them, hot and warm buckets share the home path
+type idxEBucketDirs struct {
+	Home struct {
+		HotBucketCount  numeric `json:"hot_bucket_count"`
+		WarmBucketCount numeric `json:"warm_bucket_count"`
+	} `json:"home"`
+	Cold struct {
+		BucketCount numeric `json:"bucket_count"`
+	} `json:"cold"`
+	// size is reported in MB. Indexes nothing was ever thawed into leave it out
+	Thawed struct {
+		Size numeric `json:"size"`
+	} `json:"thawed"`
+}
+
+// '/services/shcluster/member/info'
+type shcMemberInfo struct {
+	Entries []shcMemberEntry `json:"entry"`
+}
+
+type shcMemberEntry struct {
+	Content shcMemberContent `json:"content"`
+}
+
+type shcMemberContent struct {
+	Status                 string `json:"status"`
+	IsRegistered           bool   `json:"is_registered"`
+	NoArtifactReplications bool   `json:"no_artifact_replications"`
+}
+
+// '/services/shcluster/captain/info'
+type shcCaptainInfo struct {
+	Entries []shcCaptainEntry `json:"entry"`
+}
+
+type shcCaptainEntry struct {
+	Content shcCaptainContent `json:"content"`
+}
+
+// elected_captain is the time at which the current captain was elected
+type shcCaptainContent struct {
+	ID             string  `json:"id"`
+	ElectedCaptain float64 `json:"elected_captain"`
+}
+
+// '/services/shcluster/captain/members', only answered by the captain. Entries are named after the
+// GUID of the member
+type shcCaptainMembers struct {
+	Entries []shcCaptainMemberEntry `json:"entry"`
+	Paging  paging                  `json:"paging"`
+}
+
+type shcCaptainMemberEntry struct {
+	Name    string                  `json:"name"`
+	Content shcCaptainMemberContent `json:"content"`
+}
+
+type shcCaptainMemberContent struct {
+	PendingJobCount numeric `json:"pending_job_count"`
+}
+
+// '/services/shcluster/captain/jobs', the replication and fixup jobs the captain scheduled and the
+// member each of them targets
+type shcCaptainJobs struct {
+	Entries []shcCaptainJobEntry `json:"entry"`
+	Paging  paging               `json:"paging"`
+}
+
+type shcCaptainJobEntry struct {
+	Content shcCaptainJobContent `json:"content"`
+}
+
+type shcCaptainJobContent struct {
+	JobType  string `json:"job_type"`
+	JobState string `json:"job_state"`
+	Target   string `json:"target"`
+}
+
+// '/services/server/info'
+type serverInfo struct {
+	Entries []serverInfoEntry `json:"entry"`
+}
+
+type serverInfoEntry struct {
+	Content serverInfoContent `json:"content"`
+}
+
+type serverInfoContent struct {
+	GUID       string `json:"guid"`
+	ServerName string `json:"serverName"`
+	Version    string `json:"version"`
+}
+
+// '/services/deployment/server/clients'
+type deploymentClients struct {
+	Entries []dcEntry `json:"entry"`
+	Paging  paging    `json:"paging"`
+}
+
+type dcEntry struct {
+	Content dcContent `json:"content"`
+}
+
+// serverClasses is keyed by the name of every server class the client is a member of
+type dcContent struct {
+	ServerClasses map[string]any `json:"serverClasses"`
+}
+
+// '/services/server/status/resource-usage/hostwide'
+type hostwideUsage struct {
+	Entries []hostwideEntry `json:"entry"`
+}
+
+type hostwideEntry struct {
+	Content hostwideContent `json:"content"`
+}
+
+// memory is reported in MB
+type hostwideContent struct {
+	CPUSystemPct numeric `json:"cpu_system_pct"`
+	CPUUserPct   numeric `json:"cpu_user_pct"`
+	MemUsedMB    numeric `json:"mem_used"`
+}
+
+// '/services/server/status/resource-usage/splunk-processes'
+type processUsage struct {
+	Entries []processEntry `json:"entry"`
+}
+
+type processEntry struct {
+	Content processContent `json:"content"`
+}
+
+// one entry per running process, memory is reported in MB
+type processContent struct {
+	Process   string  `json:"process"`
+	PctCPU    numeric `json:"pct_cpu"`
+	MemUsedMB numeric `json:"mem_used"`
+}
+
+// '/services/server/status/partitions-space'
+type partitionsSpace struct {
+	Entries []psEntry `json:"entry"`
+}
+
+type psEntry struct {
+	Content psContent `json:"content"`
+}
+
+// one entry per partition Splunk writes to, capacity and free space are reported in MB
+type psContent struct {
+	MountPoint string  `json:"mount_point"`
+	Capacity   numeric `json:"capacity"`
+	Free       numeric `json:"free"`
+}
+
+// '/services/cluster/master/generation', only served by the manager of an indexer cluster
+type clusterGeneration struct {
+	Entries []cgEntry `json:"entry"`
+}
+
+type cgEntry struct {
+	Content cgContent `json:"content"`
+}
+
+// whether every bucket has as many copies as the replication factor and as many searchable
+// copies as the search factor ask for
+type cgContent struct {
+	ReplicationFactorMet numeric `json:"replication_factor_met"`
+	SearchFactorMet      numeric `json:"search_factor_met"`
+}
+
+// '/services/cluster/config'
+type clusterConfig struct {
+	Entries []ccEntry `json:"entry"`
+}
+
+type ccEntry struct {
+	Content ccContent `json:"content"`
+}
+
+type ccContent struct {
+	ReplicationFactor numeric `json:"replication_factor"`
+	SearchFactor      numeric `json:"search_factor"`
+}
+
+// '/services/cluster/master/indexes'
+type clusterIndexes struct {
+	Entries []ciEntry `json:"entry"`
+	Paging  paging    `json:"paging"`
+}
+
+type ciEntry struct {
+	Name    string    `json:"name"`
+	Content ciContent `json:"content"`
+}
+
+// replicated_copies_tracker has one slot per copy the replication factor asks for, each
+// counting how many of the index's buckets have that copy against how many should
+type ciContent struct {
+	IsSearchable            numeric         `json:"is_searchable"`
+	ReplicatedCopiesTracker []ciCopyTracker `json:"replicated_copies_tracker"`
+}
+
+type ciCopyTracker struct {
+	ActualCopiesPerSlot  numeric `json:"actual_copies_per_slot"`
+	ExpectedTotalPerSlot numeric `json:"expected_total_per_slot"`
+}
+
+// '/services/cluster/master/peers', entries are named after the GUID of the peer
+type clusterPeers struct {
+	Entries []cpEntry `json:"entry"`
+	Paging  paging    `json:"paging"`
+}
+
+type cpEntry struct {
+	Name    string    `json:"name"`
+	Content cpContent `json:"content"`
+}
+
+// label is the server name of the peer. pending_job_count counts the fixup jobs the manager has
+// queued for the peer, which it works through while the cluster recovers. replication_count counts
+// the bucket replications in progress with the peer as source or target
+type cpContent struct {
+	Label            string  `json:"label"`
+	Status           string  `json:"status"`
+	IsSearchable     numeric `json:"is_searchable"`
+	PendingJobCount  numeric `json:"pending_job_count"`
+	ReplicationCount numeric `json:"replication_count"`
+}
+
+// '/services/alerts/fired_alerts/-', one entry per triggered alert of every saved search
+type firedAlerts struct {
+	Entries []faEntry `json:"entry"`
+	Paging  paging    `json:"paging"`
+}
+
+type faEntry struct {
+	ACL     faACL     `json:"acl"`
+	Content faContent `json:"content"`
+}
+
+// app the saved search that triggered the alert belongs to
+type faACL struct {
+	App string `json:"app"`
+}
+
+// trigger_time is in seconds since the epoch
+type faContent struct {
+	TriggerTime numeric `json:"trigger_time"`
+}
+
+// '/services/licenser/pools'
+type licensePools struct {
+	Entries []lpEntry `json:"entry"`
+	Paging  paging    `json:"paging"`
+}
+
+type lpEntry struct {
+	Name    string    `json:"name"`
+	Content lpContent `json:"content"`
+}
+
+// quota is "MAX" rather than a number of bytes for pools using the whole stack quota
+type lpContent struct {
+	Quota     numeric `json:"quota"`
+	UsedBytes numeric `json:"used_bytes"`
+}
+
+// '/services/licenser/slaves', only the number of entries is of interest
+type licenseSlaves struct {
+	Entries []json.RawMessage `json:"entry"`
+	Paging  paging            `json:"paging"`
+}
+
+// '/services/licenser/messages', pool_id is empty for messages concerning the whole stack
+type licenseMessages struct {
+	Entries []lmEntry `json:"entry"`
+	Paging  paging    `json:"paging"`
+}
+
+type lmEntry struct {
+	Content lmContent `json:"content"`
+}
+
+// severity is one of INFO, WARN or ERROR
+type lmContent struct {
+	Category string `json:"category"`
+	Severity string `json:"severity"`
+	PoolID   string `json:"pool_id"`
+}
+
+// '/services/data/inputs/http'
+type hecTokens struct {
+	Entries []hecEntry `json:"entry"`
+	Paging  paging     `json:"paging"`
+}
+
+// name is the token's stanza, e.g. 'http://my_token'
+type hecEntry struct {
+	Name    string     `json:"name"`
+	Content hecContent `json:"content"`
+}
+
+type hecContent struct {
+	Disabled numeric `json:"disabled"`
+}
+
+// '/services/search/jobs', filtered down to the jobs that are not done yet
+type activeSearchJobs struct {
+	Entries []activeSearchJob `json:"entry"`
+	Paging  paging            `json:"paging"`
+}
+
+// published is the time the job was dispatched and author the user who dispatched it
+type activeSearchJob struct {
+	Published timestamp              `json:"published"`
+	Author    string                 `json:"author"`
+	Content   activeSearchJobContent `json:"content"`
+}
+
+// dispatchState is one of QUEUED, PARSING, RUNNING, FINALIZING, PAUSED, DONE or FAILED
+type activeSearchJobContent struct {
+	DispatchState    string `json:"dispatchState"`
+	IsRealTimeSearch bool   `json:"isRealTimeSearch"`
+}
+
+// '/services/search/jobs', listing every job whose artifact is still in the dispatch directory
+type dispatchArtifacts struct {
+	Entries []dispatchArtifact `json:"entry"`
+	Paging  paging             `json:"paging"`
+}
+
+type dispatchArtifact struct {
+	Content dispatchArtifactContent `json:"content"`
+}
+
+// diskUsage is reported in bytes
+type dispatchArtifactContent struct {
+	DiskUsage numeric `json:"diskUsage"`
+}
+
+// '/services/authentication/users'
+type users struct {
+	Entries []userEntry `json:"entry"`
+	Paging  paging      `json:"paging"`
+}
+
+type userEntry struct {
+	Name    string      `json:"name"`
+	Content userContent `json:"content"`
+}
+
+type userContent struct {
+	Roles []string `json:"roles"`
+}
+
+// '/services/authorization/roles'
+type roles struct {
+	Entries []roleEntry `json:"entry"`
+	Paging  paging      `json:"paging"`
+}
+
+type roleEntry struct {
+	Name    string      `json:"name"`
+	Content roleContent `json:"content"`
+}
+
+// srchJobsQuota is the number of historical searches a user holding the role can run at once,
+// imported_srchJobsQuota the one inherited from the roles it imports. 0 sets no quota
+type roleContent struct {
+	SrchJobsQuota         numeric `json:"srchJobsQuota"`
+	ImportedSrchJobsQuota numeric `json:"imported_srchJobsQuota"`
+}
+
+// '/services/admin/cacheman/_metrics', only served by indexers with SmartStore indexes
+type cacheManagerMetrics struct {
+	Entries []cacheManagerEntry `json:"entry"`
+}
+
+// name is the name of the index
+type cacheManagerEntry struct {
+	Name    string              `json:"name"`
+	Content cacheManagerContent `json:"content"`
+}
+
+// The hits, misses and evictions are counted since splunkd started. pending_uploads is the number
+// of buckets waiting to be uploaded to remote storage
+type cacheManagerContent struct {
+	CacheHits      numeric `json:"cache_hits"`
+	CacheMisses    numeric `json:"cache_misses"`
+	PendingUploads numeric `json:"pending_uploads"`
+	Evictions      numeric `json:"evictions"`
+}
+
+// '/services/server/introspection/search/results_cache'
+type searchResultsCache struct {
+	Entries []searchResultsCacheEntry `json:"entry"`
+}
+
+type searchResultsCacheEntry struct {
+	Content searchResultsCacheContent `json:"content"`
+}
+
+// hits and misses are the lookups of the results cache since splunkd started. enabled is false
+// when the cache is turned off in limits.conf
+type searchResultsCacheContent struct {
+	Enabled numeric `json:"enabled"`
+	Hits    numeric `json:"hits"`
+	Misses  numeric `json:"misses"`
+}
+
+// '/services/server/status/limits/search-concurrency'
+type searchConcurrency struct {
+	Entries []searchConcurrencyEntry `json:"entry"`
+}
+
+type searchConcurrencyEntry struct {
+	Content searchConcurrencyContent `json:"content"`
+}
+
+// max_hist_searches and max_rt_searches are the number of historical and real-time searches
+// the instance runs at once before it starts queueing them
+type searchConcurrencyContent struct {
+	MaxHistSearches numeric `json:"max_hist_searches"`
+	MaxRtSearches   numeric `json:"max_rt_searches"`
+}
+
+// '/services/admin/summarization?by_tstats=t'
+type dataModelSummaries struct {
+	Entries []dmsEntry `json:"entry"`
+	Paging  paging     `json:"paging"`
+}
+
+// name is 'tstats:DM_<app>_<data model>'
+type dmsEntry struct {
+	Name    string     `json:"name"`
+	ACL     dmsACL     `json:"acl"`
+	Content dmsContent `json:"content"`
+}
+
+type dmsACL struct {
+	App string `json:"app"`
+}
+
+// summary.complete is the fraction of the acceleration time range covered by the summary,
+// summary.size is in bytes
+type dmsContent struct {
+	Complete numeric `json:"summary.complete"`
+	Size     numeric `json:"summary.size"`
+}
+
+// Name of the data model the summary accelerates
+func (e *dmsEntry) dataModel() string {
+	return strings.TrimPrefix(strings.TrimPrefix(e.Name, "tstats:DM_"), e.ACL.App+"_")
 }